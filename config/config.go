@@ -1,6 +1,264 @@
 package config
 
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
 const (
 	ApplicationName      string = "aws-account-shredder"
 	ApplicationNamespace string = "aws-account-shredder"
 )
+
+// Environment variables used to tune the shredder, all of them are optional
+const (
+	// LogGroupExcludePrefixesEnvVar is a comma separated list of CloudWatch log group name prefixes that are never deleted
+	LogGroupExcludePrefixesEnvVar string = "LOG_GROUP_EXCLUDE_PREFIXES"
+	// KMSPendingWindowDaysEnvVar is the number of days a KMS key stays in PendingDeletion before AWS deletes it
+	KMSPendingWindowDaysEnvVar string = "KMS_PENDING_WINDOW_DAYS"
+	// SecretsRecoveryWindowDaysEnvVar is the number of days a deleted secret can still be restored, 0 deletes it immediately
+	SecretsRecoveryWindowDaysEnvVar string = "SECRETS_RECOVERY_WINDOW_DAYS"
+	// DefaultVpcModeEnvVar selects what happens to the default VPC of every region, see the DefaultVpcMode constants
+	DefaultVpcModeEnvVar string = "DEFAULT_VPC_MODE"
+	// Ec2SelectionModeEnvVar selects which EC2 instances are terminated, see the Ec2SelectionMode constants
+	Ec2SelectionModeEnvVar string = "EC2_SELECTION_MODE"
+	// Ec2SelectionTagKeysEnvVar is a comma separated list of tag key prefixes selecting instances in tag-key mode
+	Ec2SelectionTagKeysEnvVar string = "EC2_SELECTION_TAG_KEYS"
+	// Ec2SelectionTagValueRegexEnvVar is the regular expression a tag value has to match to select an instance in tag-value mode
+	Ec2SelectionTagValueRegexEnvVar string = "EC2_SELECTION_TAG_VALUE_REGEX"
+	// Ec2ExcludeTagsEnvVar is a comma separated list of key or key=value tags protecting instances from termination in every mode
+	Ec2ExcludeTagsEnvVar string = "EC2_EXCLUDE_TAGS"
+	// DeletionWaitTimeoutSecondsEnvVar bounds how long VPC teardown waits for instances, NAT gateways and load balancers to be gone, 0 disables waiting
+	DeletionWaitTimeoutSecondsEnvVar string = "DELETION_WAIT_TIMEOUT_SECONDS"
+)
+
+// Account CR annotations overriding the EC2 selection environment variables for a single account
+const (
+	Ec2SelectionModeAnnotation          string = "shredder.aws.managed.openshift.io/ec2-selection-mode"
+	Ec2SelectionTagKeysAnnotation       string = "shredder.aws.managed.openshift.io/ec2-selection-tag-keys"
+	Ec2SelectionTagValueRegexAnnotation string = "shredder.aws.managed.openshift.io/ec2-selection-tag-value-regex"
+	Ec2ExcludeTagsAnnotation            string = "shredder.aws.managed.openshift.io/ec2-exclude-tags"
+)
+
+// Bounds and default of the KMS key deletion waiting period, as enforced by AWS
+const (
+	MinKMSPendingWindowDays     int64 = 7
+	MaxKMSPendingWindowDays     int64 = 30
+	DefaultKMSPendingWindowDays int64 = MinKMSPendingWindowDays
+)
+
+// Bounds of the Secrets Manager recovery window, as enforced by AWS
+// the default of 0 skips the recovery window and deletes secrets right away
+const (
+	MinSecretsRecoveryWindowDays     int64 = 7
+	MaxSecretsRecoveryWindowDays     int64 = 30
+	DefaultSecretsRecoveryWindowDays int64 = 0
+)
+
+// Bounds of the deletion wait timeout, every region pass of every account can wait this long for its VPCs and again for its default VPC
+const (
+	MaxDeletionWaitTimeoutSeconds     int64 = 1800
+	DefaultDeletionWaitTimeoutSeconds int64 = 0
+)
+
+// Supported values of DEFAULT_VPC_MODE
+const (
+	// DefaultVpcModeKeep leaves the default VPC untouched
+	DefaultVpcModeKeep string = "keep"
+	// DefaultVpcModeReset strips the default VPC back to the resources AWS creates with it
+	DefaultVpcModeReset string = "reset"
+	// DefaultVpcModeRecreate deletes the default VPC and creates a new one
+	DefaultVpcModeRecreate string = "recreate"
+)
+
+// Supported values of EC2_SELECTION_MODE
+const (
+	// Ec2SelectionModeClusterTags selects instances tagged by an OpenShift or Kubernetes cluster
+	Ec2SelectionModeClusterTags string = "cluster-tags"
+	// Ec2SelectionModeAll selects every instance
+	Ec2SelectionModeAll string = "all"
+	// Ec2SelectionModeTagKey selects instances with a tag key starting with one of the configured prefixes
+	Ec2SelectionModeTagKey string = "tag-key"
+	// Ec2SelectionModeTagValue selects instances with a tag value matching the configured regular expression
+	Ec2SelectionModeTagValue string = "tag-value"
+)
+
+// Ec2Selection describes which EC2 instances of an account are terminated
+type Ec2Selection struct {
+	Mode          string
+	TagKeys       []string
+	TagValueRegex *regexp.Regexp
+	// ExcludeTags maps tag keys to the value protecting an instance, an empty value protects whatever the value
+	ExcludeTags map[string]string
+}
+
+// String returns a summary of the selection, suitable for logging
+func (s Ec2Selection) String() string {
+	summary := "mode=" + s.Mode
+	switch s.Mode {
+	case Ec2SelectionModeTagKey:
+		summary += " tagKeys=" + strings.Join(s.TagKeys, ",")
+	case Ec2SelectionModeTagValue:
+		summary += " tagValueRegex=" + s.TagValueRegex.String()
+	}
+	if len(s.ExcludeTags) > 0 {
+		var excludeTags []string
+		for key, value := range s.ExcludeTags {
+			if value != "" {
+				key += "=" + value
+			}
+			excludeTags = append(excludeTags, key)
+		}
+		sort.Strings(excludeTags)
+		summary += " excludeTags=" + strings.Join(excludeTags, ",")
+	}
+	return summary
+}
+
+// GetLogGroupExcludePrefixes returns the log group name prefixes that have to be preserved
+func GetLogGroupExcludePrefixes() []string {
+	return getListFromEnv(LogGroupExcludePrefixesEnvVar)
+}
+
+// GetKMSPendingWindowDays returns the waiting period used when scheduling KMS key deletion
+// an invalid value results in the default being returned along with an error
+func GetKMSPendingWindowDays() (int64, error) {
+	value := strings.TrimSpace(os.Getenv(KMSPendingWindowDaysEnvVar))
+	if value == "" {
+		return DefaultKMSPendingWindowDays, nil
+	}
+	days, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return DefaultKMSPendingWindowDays, fmt.Errorf("invalid %s %q: %v", KMSPendingWindowDaysEnvVar, value, err)
+	}
+	if days < MinKMSPendingWindowDays || days > MaxKMSPendingWindowDays {
+		return DefaultKMSPendingWindowDays, fmt.Errorf("%s must be between %d and %d, got %d", KMSPendingWindowDaysEnvVar, MinKMSPendingWindowDays, MaxKMSPendingWindowDays, days)
+	}
+	return days, nil
+}
+
+// GetSecretsRecoveryWindowDays returns the recovery window used when deleting secrets, 0 meaning no recovery window
+// an invalid value results in the default being returned along with an error
+func GetSecretsRecoveryWindowDays() (int64, error) {
+	value := strings.TrimSpace(os.Getenv(SecretsRecoveryWindowDaysEnvVar))
+	if value == "" {
+		return DefaultSecretsRecoveryWindowDays, nil
+	}
+	days, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return DefaultSecretsRecoveryWindowDays, fmt.Errorf("invalid %s %q: %v", SecretsRecoveryWindowDaysEnvVar, value, err)
+	}
+	if days != 0 && (days < MinSecretsRecoveryWindowDays || days > MaxSecretsRecoveryWindowDays) {
+		return DefaultSecretsRecoveryWindowDays, fmt.Errorf("%s must be 0 or between %d and %d, got %d", SecretsRecoveryWindowDaysEnvVar, MinSecretsRecoveryWindowDays, MaxSecretsRecoveryWindowDays, days)
+	}
+	return days, nil
+}
+
+// GetDeletionWaitTimeout returns how long VPC teardown waits for its dependencies to be deleted, 0 meaning no wait
+// an invalid value results in the default being returned along with an error
+func GetDeletionWaitTimeout() (time.Duration, error) {
+	defaultTimeout := time.Duration(DefaultDeletionWaitTimeoutSeconds) * time.Second
+	value := strings.TrimSpace(os.Getenv(DeletionWaitTimeoutSecondsEnvVar))
+	if value == "" {
+		return defaultTimeout, nil
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return defaultTimeout, fmt.Errorf("invalid %s %q: %v", DeletionWaitTimeoutSecondsEnvVar, value, err)
+	}
+	if seconds < 0 || seconds > MaxDeletionWaitTimeoutSeconds {
+		return defaultTimeout, fmt.Errorf("%s must be between 0 and %d, got %d", DeletionWaitTimeoutSecondsEnvVar, MaxDeletionWaitTimeoutSeconds, seconds)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// GetDefaultVpcMode returns how the default VPC has to be handled
+// an invalid value results in DefaultVpcModeKeep being returned along with an error
+func GetDefaultVpcMode() (string, error) {
+	value := strings.ToLower(strings.TrimSpace(os.Getenv(DefaultVpcModeEnvVar)))
+	switch value {
+	case "":
+		return DefaultVpcModeKeep, nil
+	case DefaultVpcModeKeep, DefaultVpcModeReset, DefaultVpcModeRecreate:
+		return value, nil
+	}
+	return DefaultVpcModeKeep, fmt.Errorf("%s must be one of %s, %s or %s, got %q", DefaultVpcModeEnvVar, DefaultVpcModeKeep, DefaultVpcModeReset, DefaultVpcModeRecreate, value)
+}
+
+// GetEc2Selection returns which EC2 instances have to be terminated in an account
+// the annotations of the account CR take precedence over the environment, setting by setting
+// an invalid selection results in the cluster-tags selection being returned along with an error
+func GetEc2Selection(annotations map[string]string) (Ec2Selection, error) {
+	defaultSelection := Ec2Selection{Mode: Ec2SelectionModeClusterTags}
+
+	setting := func(annotation, envVar string) string {
+		if value, ok := annotations[annotation]; ok {
+			return strings.TrimSpace(value)
+		}
+		return strings.TrimSpace(os.Getenv(envVar))
+	}
+
+	selection := Ec2Selection{
+		Mode:    strings.ToLower(setting(Ec2SelectionModeAnnotation, Ec2SelectionModeEnvVar)),
+		TagKeys: splitList(setting(Ec2SelectionTagKeysAnnotation, Ec2SelectionTagKeysEnvVar)),
+	}
+
+	for _, tag := range splitList(setting(Ec2ExcludeTagsAnnotation, Ec2ExcludeTagsEnvVar)) {
+		if selection.ExcludeTags == nil {
+			selection.ExcludeTags = map[string]string{}
+		}
+		keyValue := strings.SplitN(tag, "=", 2)
+		if len(keyValue) == 2 {
+			selection.ExcludeTags[keyValue[0]] = keyValue[1]
+		} else {
+			selection.ExcludeTags[keyValue[0]] = ""
+		}
+	}
+	// exclusions stay in force when the selection is invalid
+	defaultSelection.ExcludeTags = selection.ExcludeTags
+
+	switch selection.Mode {
+	case "":
+		selection.Mode = Ec2SelectionModeClusterTags
+	case Ec2SelectionModeClusterTags, Ec2SelectionModeAll:
+	case Ec2SelectionModeTagKey:
+		if len(selection.TagKeys) == 0 {
+			return defaultSelection, fmt.Errorf("%s mode needs at least one tag key in %s", Ec2SelectionModeTagKey, Ec2SelectionTagKeysEnvVar)
+		}
+	case Ec2SelectionModeTagValue:
+		value := setting(Ec2SelectionTagValueRegexAnnotation, Ec2SelectionTagValueRegexEnvVar)
+		if value == "" {
+			return defaultSelection, fmt.Errorf("%s mode needs a regular expression in %s", Ec2SelectionModeTagValue, Ec2SelectionTagValueRegexEnvVar)
+		}
+		regex, err := regexp.Compile(value)
+		if err != nil {
+			return defaultSelection, fmt.Errorf("invalid %s %q: %v", Ec2SelectionTagValueRegexEnvVar, value, err)
+		}
+		selection.TagValueRegex = regex
+	default:
+		return defaultSelection, fmt.Errorf("%s must be one of %s, %s, %s or %s, got %q", Ec2SelectionModeEnvVar, Ec2SelectionModeClusterTags, Ec2SelectionModeAll, Ec2SelectionModeTagKey, Ec2SelectionModeTagValue, selection.Mode)
+	}
+	return selection, nil
+}
+
+// getListFromEnv splits a comma separated environment variable, ignoring empty entries
+func getListFromEnv(name string) []string {
+	return splitList(os.Getenv(name))
+}
+
+// splitList splits a comma separated list, ignoring empty entries
+func splitList(list string) []string {
+	var values []string
+	for _, value := range strings.Split(list, ",") {
+		value = strings.TrimSpace(value)
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
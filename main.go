@@ -2,14 +2,17 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/wafv2"
 	routev1 "github.com/openshift/api/route/v1"
 	"github.com/openshift/aws-account-operator/pkg/apis/aws/v1alpha1"
+	shredderConfig "github.com/openshift/aws-account-shredder/config"
 	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
 	"github.com/openshift/aws-account-shredder/pkg/awsManager"
 	"github.com/openshift/aws-account-shredder/pkg/awsv1alpha1"
@@ -74,6 +77,26 @@ func main() {
 	if err != nil {
 		log.Error(err, "Failed to create new AWSclient")
 	}
+
+	// optional settings read from the environment
+	logGroupExcludePrefixes := shredderConfig.GetLogGroupExcludePrefixes()
+	kmsPendingWindowDays, err := shredderConfig.GetKMSPendingWindowDays()
+	if err != nil {
+		log.Error(err, "Invalid KMS pending window, using the default", "Days", kmsPendingWindowDays)
+	}
+	secretsRecoveryWindowDays, err := shredderConfig.GetSecretsRecoveryWindowDays()
+	if err != nil {
+		log.Error(err, "Invalid Secrets Manager recovery window, using the default", "Days", secretsRecoveryWindowDays)
+	}
+	defaultVpcMode, err := shredderConfig.GetDefaultVpcMode()
+	if err != nil {
+		log.Error(err, "Invalid default VPC mode, leaving default VPCs untouched")
+	}
+	deletionWaitTimeout, err := shredderConfig.GetDeletionWaitTimeout()
+	if err != nil {
+		log.Error(err, "Invalid deletion wait timeout, not waiting for deletions", "Timeout", deletionWaitTimeout)
+	}
+
 	for {
 		// reading the account ID to be cleared
 		accountCRList, err := awsv1alpha1.GetAccountCRsToReset(context.TODO(), cli)
@@ -93,6 +116,13 @@ func main() {
 				continue
 			}
 
+			// the account CR can override the EC2 selection of the environment
+			ec2Selection, err := shredderConfig.GetEc2Selection(account.Annotations)
+			if err != nil {
+				logger.Error(err, "Invalid EC2 selection, only selecting instances with cluster tags")
+			}
+			logger.Info("EC2 instance selection", "Selection", ec2Selection.String())
+
 			// assuming roles for the given AccountID
 			RoleArnParameter := "arn:aws:iam::" + account.Spec.AwsAccountID + ":role/OrganizationAccountAccessRole"
 			assumedRole, err := awsClient.AssumeRole(&sts.AssumeRoleInput{RoleArn: aws.String(RoleArnParameter), RoleSessionName: aws.String(sessionName)})
@@ -108,41 +138,104 @@ func main() {
 			assumedSecretKey := *assumedRole.Credentials.SecretAccessKey
 			assumedSessionToken := *assumedRole.Credentials.SessionToken
 
+			regionalClient := func(region string) (clientpkg.Client, error) {
+				return clientpkg.NewClient(assumedAccessKey, assumedSecretKey, assumedSessionToken, region)
+			}
+
 			var allErrors []error
+
+			// account-wide services are cleaned once, ahead of the regional pass, with their metrics labelled "global"
+			globalLogger := log.WithValues("AccountName", account.Name, "AccountID", account.Spec.AwsAccountID, "Region", clientpkg.GlobalRegion)
+			globalClient, err := clientpkg.NewGlobalClient(assumedAccessKey, assumedSecretKey, assumedSessionToken)
+			if err != nil {
+				globalLogger.Error(err, "Failed to initialize new AWS client")
+				localMetrics.Metrics.AccountFail.Inc()
+				continue
+			}
+			// edge resources go first, they keep Route53 records, certificates and load balancers in use
+			allErrors = append(allErrors, awsManager.CleanCloudFront(globalClient, globalLogger))
+			allErrors = append(allErrors, awsManager.CleanWafv2(globalClient, wafv2.ScopeCloudfront, globalLogger))
+			allErrors = append(allErrors, awsManager.CleanGlobalAccelerator(globalClient, globalLogger))
+			// S3 buckets are listed for the whole account, each one is deleted through a client for its own region
+			// while its metrics stay under the global label
+			allErrors = append(allErrors, awsManager.CleanS3Instances(globalClient, regionalClient, globalLogger))
+			allErrors = append(allErrors, awsManager.CleanUpAwsRoute53(globalClient, globalLogger))
+
 			for _, region := range supportedRegions {
 				logger = log.WithValues("AccountName", account.Name, "AccountID", account.Spec.AwsAccountID, "Region", region)
-				assumedRoleClient, err := clientpkg.NewClient(assumedAccessKey, assumedSecretKey, assumedSessionToken, region)
+				assumedRoleClient, err := regionalClient(region)
 				if err != nil {
 					logger.Error(err, "Failed to initialize new AWS client")
 					localMetrics.Metrics.AccountFail.Inc()
 					continue
 				}
-				allErrors = append(allErrors, awsManager.CleanS3Instances(assumedRoleClient, logger))
-				allErrors = append(allErrors, awsManager.CleanEc2Instances(assumedRoleClient, logger))
-				allErrors = append(allErrors, awsManager.CleanUpAwsRoute53(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanEks(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanEcs(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanEcrRepositories(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanEc2Instances(assumedRoleClient, ec2Selection, logger))
+				allErrors = append(allErrors, awsManager.CleanStateMachines(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanEventBridge(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanLambda(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanKinesis(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanDynamoDB(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanSns(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanSqsQueues(assumedRoleClient, logger))
 				allErrors = append(allErrors, awsManager.CleanEFSMountTargets(assumedRoleClient, logger))
 				allErrors = append(allErrors, awsManager.CleanEFS(assumedRoleClient, logger))
-				allErrors = append(allErrors, awsManager.CleanVpcInstances(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanVpcEndpointServices(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanElbv2(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanWafv2(assumedRoleClient, wafv2.ScopeRegional, logger))
+				allErrors = append(allErrors, awsManager.CleanAcmCertificates(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanElastiCache(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanElasticsearch(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanRedshift(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanVpcPeeringConnections(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanTransitGateways(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanRoute53Resolver(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanVpcInstances(assumedRoleClient, deletionWaitTimeout, logger))
+				switch defaultVpcMode {
+				case shredderConfig.DefaultVpcModeReset:
+					allErrors = append(allErrors, awsManager.ResetDefaultVpc(assumedRoleClient, deletionWaitTimeout, logger))
+				case shredderConfig.DefaultVpcModeRecreate:
+					allErrors = append(allErrors, awsManager.RecreateDefaultVpc(assumedRoleClient, deletionWaitTimeout, logger))
+				}
+				allErrors = append(allErrors, awsManager.CleanDhcpOptions(assumedRoleClient, logger))
 				allErrors = append(allErrors, awsManager.CleanEbsSnapshots(assumedRoleClient, logger))
 				allErrors = append(allErrors, awsManager.CleanEbsVolumes(assumedRoleClient, logger))
 				allErrors = append(allErrors, awsManager.CleanEIPAddresses(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanEc2Resources(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanCloudWatch(assumedRoleClient, logGroupExcludePrefixes, logger))
+				allErrors = append(allErrors, awsManager.CleanSecrets(assumedRoleClient, secretsRecoveryWindowDays, logger))
+				allErrors = append(allErrors, awsManager.CleanSsm(assumedRoleClient, logger))
+				allErrors = append(allErrors, awsManager.CleanKms(assumedRoleClient, kmsPendingWindowDays, logger))
 
 			}
 			// After cleaning up every region we set the account state to Ready if no errors were encountered
+			// buckets under compliance retention are expected to outlive the pass: the account is neither reset,
+			// as the buckets are still there, nor counted as failed, as nothing can be done before the retention ends
 			resetAccount := true
+			var retentionErr *awsManager.S3RetentionError
 			for _, err := range allErrors {
-				if err != nil {
-					resetAccount = false
+				if err == nil {
+					continue
+				}
+				var bucketRetentionErr *awsManager.S3RetentionError
+				if errors.As(err, &bucketRetentionErr) {
+					retentionErr = bucketRetentionErr
+					continue
 				}
+				resetAccount = false
 			}
-			if resetAccount {
+			if !resetAccount {
+				localMetrics.Metrics.AccountFail.Inc()
+			} else if retentionErr != nil {
+				globalLogger.Info("Account is not reset until its S3 buckets under compliance retention can be deleted", "UnshreddableUntil", retentionErr.RetainUntil)
+			} else {
 				err := awsv1alpha1.ResetAccountStatus(cli, account)
 				if err != nil {
 					logger.Error(err, "Failed to reset account status")
 				}
 				localMetrics.Metrics.AccountSuccess.Inc()
-			} else {
-				localMetrics.Metrics.AccountFail.Inc()
 			}
 			duration := time.Since(startTime)
 			localMetrics.Metrics.DurationSeconds.Observe(float64(duration / time.Second))
@@ -4,21 +4,70 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice/elasticsearchserviceiface"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/firehose/firehoseiface"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator/globalacceleratoriface"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/route53resolver/route53resolveriface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
-	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/s3control/s3controliface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
 )
 
 //go:generate mockgen -source=./client.go -destination=../mock/client_generated.go -package=mock
@@ -63,6 +112,52 @@ type Client interface {
 	DescribeVolumes(input *ec2.DescribeVolumesInput) (*ec2.DescribeVolumesOutput, error)
 	DescribeAddresses(input *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error)
 	ReleaseAddress(input *ec2.ReleaseAddressInput) (*ec2.ReleaseAddressOutput, error)
+	DescribeTransitGatewayAttachments(input *ec2.DescribeTransitGatewayAttachmentsInput) (*ec2.DescribeTransitGatewayAttachmentsOutput, error)
+	DeleteTransitGatewayVpcAttachment(input *ec2.DeleteTransitGatewayVpcAttachmentInput) (*ec2.DeleteTransitGatewayVpcAttachmentOutput, error)
+	DeleteTransitGatewayPeeringAttachment(input *ec2.DeleteTransitGatewayPeeringAttachmentInput) (*ec2.DeleteTransitGatewayPeeringAttachmentOutput, error)
+	DescribeTransitGatewayRouteTables(input *ec2.DescribeTransitGatewayRouteTablesInput) (*ec2.DescribeTransitGatewayRouteTablesOutput, error)
+	DeleteTransitGatewayRouteTable(input *ec2.DeleteTransitGatewayRouteTableInput) (*ec2.DeleteTransitGatewayRouteTableOutput, error)
+	DescribeTransitGateways(input *ec2.DescribeTransitGatewaysInput) (*ec2.DescribeTransitGatewaysOutput, error)
+	DeleteTransitGateway(input *ec2.DeleteTransitGatewayInput) (*ec2.DeleteTransitGatewayOutput, error)
+	DescribeVpcPeeringConnections(input *ec2.DescribeVpcPeeringConnectionsInput) (*ec2.DescribeVpcPeeringConnectionsOutput, error)
+	DeleteVpcPeeringConnection(input *ec2.DeleteVpcPeeringConnectionInput) (*ec2.DeleteVpcPeeringConnectionOutput, error)
+	DescribeCustomerGateways(input *ec2.DescribeCustomerGatewaysInput) (*ec2.DescribeCustomerGatewaysOutput, error)
+	DeleteCustomerGateway(input *ec2.DeleteCustomerGatewayInput) (*ec2.DeleteCustomerGatewayOutput, error)
+	DescribeEgressOnlyInternetGateways(input *ec2.DescribeEgressOnlyInternetGatewaysInput) (*ec2.DescribeEgressOnlyInternetGatewaysOutput, error)
+	DeleteEgressOnlyInternetGateway(input *ec2.DeleteEgressOnlyInternetGatewayInput) (*ec2.DeleteEgressOnlyInternetGatewayOutput, error)
+	DescribeFlowLogs(input *ec2.DescribeFlowLogsInput) (*ec2.DescribeFlowLogsOutput, error)
+	DeleteFlowLogs(input *ec2.DeleteFlowLogsInput) (*ec2.DeleteFlowLogsOutput, error)
+	DisassociateVpcCidrBlock(input *ec2.DisassociateVpcCidrBlockInput) (*ec2.DisassociateVpcCidrBlockOutput, error)
+	DescribeDhcpOptions(input *ec2.DescribeDhcpOptionsInput) (*ec2.DescribeDhcpOptionsOutput, error)
+	DeleteDhcpOptions(input *ec2.DeleteDhcpOptionsInput) (*ec2.DeleteDhcpOptionsOutput, error)
+	DescribeVpcEndpointServiceConfigurations(input *ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.DescribeVpcEndpointServiceConfigurationsOutput, error)
+	DeleteVpcEndpointServiceConfigurations(input *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error)
+	DescribeVpcEndpointConnections(input *ec2.DescribeVpcEndpointConnectionsInput) (*ec2.DescribeVpcEndpointConnectionsOutput, error)
+	RejectVpcEndpointConnections(input *ec2.RejectVpcEndpointConnectionsInput) (*ec2.RejectVpcEndpointConnectionsOutput, error)
+	CreateDefaultVpc(input *ec2.CreateDefaultVpcInput) (*ec2.CreateDefaultVpcOutput, error)
+	AssociateDhcpOptions(input *ec2.AssociateDhcpOptionsInput) (*ec2.AssociateDhcpOptionsOutput, error)
+	ReplaceNetworkAclAssociation(input *ec2.ReplaceNetworkAclAssociationInput) (*ec2.ReplaceNetworkAclAssociationOutput, error)
+	RevokeSecurityGroupEgress(input *ec2.RevokeSecurityGroupEgressInput) (*ec2.RevokeSecurityGroupEgressOutput, error)
+	DeleteNetworkAclEntry(input *ec2.DeleteNetworkAclEntryInput) (*ec2.DeleteNetworkAclEntryOutput, error)
+	CreateNetworkAclEntry(input *ec2.CreateNetworkAclEntryInput) (*ec2.CreateNetworkAclEntryOutput, error)
+	DeleteRoute(input *ec2.DeleteRouteInput) (*ec2.DeleteRouteOutput, error)
+	ModifyInstanceAttribute(input *ec2.ModifyInstanceAttributeInput) (*ec2.ModifyInstanceAttributeOutput, error)
+	DescribeSpotInstanceRequests(input *ec2.DescribeSpotInstanceRequestsInput) (*ec2.DescribeSpotInstanceRequestsOutput, error)
+	CancelSpotInstanceRequests(input *ec2.CancelSpotInstanceRequestsInput) (*ec2.CancelSpotInstanceRequestsOutput, error)
+	DescribeSpotFleetRequests(input *ec2.DescribeSpotFleetRequestsInput) (*ec2.DescribeSpotFleetRequestsOutput, error)
+	CancelSpotFleetRequests(input *ec2.CancelSpotFleetRequestsInput) (*ec2.CancelSpotFleetRequestsOutput, error)
+	DescribeKeyPairs(input *ec2.DescribeKeyPairsInput) (*ec2.DescribeKeyPairsOutput, error)
+	DeleteKeyPair(input *ec2.DeleteKeyPairInput) (*ec2.DeleteKeyPairOutput, error)
+	DescribePlacementGroups(input *ec2.DescribePlacementGroupsInput) (*ec2.DescribePlacementGroupsOutput, error)
+	DeletePlacementGroup(input *ec2.DeletePlacementGroupInput) (*ec2.DeletePlacementGroupOutput, error)
+	DescribeHosts(input *ec2.DescribeHostsInput) (*ec2.DescribeHostsOutput, error)
+	ReleaseHosts(input *ec2.ReleaseHostsInput) (*ec2.ReleaseHostsOutput, error)
+	DescribeCapacityReservations(input *ec2.DescribeCapacityReservationsInput) (*ec2.DescribeCapacityReservationsOutput, error)
+	CancelCapacityReservation(input *ec2.CancelCapacityReservationInput) (*ec2.CancelCapacityReservationOutput, error)
+	DescribeCarrierGateways(input *ec2.DescribeCarrierGatewaysInput) (*ec2.DescribeCarrierGatewaysOutput, error)
+	DeleteCarrierGateway(input *ec2.DeleteCarrierGatewayInput) (*ec2.DeleteCarrierGatewayOutput, error)
+	DescribeManagedPrefixLists(input *ec2.DescribeManagedPrefixListsInput) (*ec2.DescribeManagedPrefixListsOutput, error)
+	DeleteManagedPrefixList(input *ec2.DeleteManagedPrefixListInput) (*ec2.DeleteManagedPrefixListOutput, error)
 
 	//efs
 	DescribeMountTargets(input *efs.DescribeMountTargetsInput) (*efs.DescribeMountTargetsOutput, error)
@@ -77,6 +172,9 @@ type Client interface {
 	//ELBV2
 	DescribeLoadBalancers2(input *elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error)
 	DeleteLoadBalancer2(input *elbv2.DeleteLoadBalancerInput) (*elbv2.DeleteLoadBalancerOutput, error)
+	ModifyLoadBalancerAttributes2(input *elbv2.ModifyLoadBalancerAttributesInput) (*elbv2.ModifyLoadBalancerAttributesOutput, error)
+	DescribeTargetGroups(input *elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error)
+	DeleteTargetGroup(input *elbv2.DeleteTargetGroupInput) (*elbv2.DeleteTargetGroupOutput, error)
 
 	//STS
 	AssumeRole(*sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
@@ -85,25 +183,252 @@ type Client interface {
 	// S3
 	ListBuckets(*s3.ListBucketsInput) (*s3.ListBucketsOutput, error)
 	DeleteBucket(*s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error)
-	BatchDeleteBucketObjects(bucketName *string) error
+	ListObjectVersions(*s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
+	DeleteObjects(*s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+	ListMultipartUploads(*s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error)
+	AbortMultipartUpload(*s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error)
+	GetBucketVersioning(*s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error)
+	PutBucketVersioning(*s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error)
+	GetBucketPolicy(*s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error)
+	DeleteBucketPolicy(*s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error)
+	GetBucketLocation(*s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error)
+	DeleteBucketReplication(*s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error)
+	DeleteBucketLifecycle(*s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error)
+	ListBucketInventoryConfigurations(*s3.ListBucketInventoryConfigurationsInput) (*s3.ListBucketInventoryConfigurationsOutput, error)
+	DeleteBucketInventoryConfiguration(*s3.DeleteBucketInventoryConfigurationInput) (*s3.DeleteBucketInventoryConfigurationOutput, error)
+	GetObjectLockConfiguration(*s3.GetObjectLockConfigurationInput) (*s3.GetObjectLockConfigurationOutput, error)
+	PutObjectLegalHold(*s3.PutObjectLegalHoldInput) (*s3.PutObjectLegalHoldOutput, error)
+	GetObjectRetention(*s3.GetObjectRetentionInput) (*s3.GetObjectRetentionOutput, error)
 
 	// Route53
 	ListHostedZones(*route53.ListHostedZonesInput) (*route53.ListHostedZonesOutput, error)
 	DeleteHostedZone(*route53.DeleteHostedZoneInput) (*route53.DeleteHostedZoneOutput, error)
 	ListResourceRecordSets(*route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error)
 	ChangeResourceRecordSets(*route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error)
+	GetHostedZone(*route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error)
+	DisassociateVPCFromHostedZone(*route53.DisassociateVPCFromHostedZoneInput) (*route53.DisassociateVPCFromHostedZoneOutput, error)
+	ListVPCAssociationAuthorizations(*route53.ListVPCAssociationAuthorizationsInput) (*route53.ListVPCAssociationAuthorizationsOutput, error)
+	DeleteVPCAssociationAuthorization(*route53.DeleteVPCAssociationAuthorizationInput) (*route53.DeleteVPCAssociationAuthorizationOutput, error)
+	ListQueryLoggingConfigs(*route53.ListQueryLoggingConfigsInput) (*route53.ListQueryLoggingConfigsOutput, error)
+	DeleteQueryLoggingConfig(*route53.DeleteQueryLoggingConfigInput) (*route53.DeleteQueryLoggingConfigOutput, error)
+	ListHealthChecks(*route53.ListHealthChecksInput) (*route53.ListHealthChecksOutput, error)
+	DeleteHealthCheck(*route53.DeleteHealthCheckInput) (*route53.DeleteHealthCheckOutput, error)
+	ListTrafficPolicyInstances(*route53.ListTrafficPolicyInstancesInput) (*route53.ListTrafficPolicyInstancesOutput, error)
+	DeleteTrafficPolicyInstance(*route53.DeleteTrafficPolicyInstanceInput) (*route53.DeleteTrafficPolicyInstanceOutput, error)
+	GetDNSSEC(*route53.GetDNSSECInput) (*route53.GetDNSSECOutput, error)
+	DisableHostedZoneDNSSEC(*route53.DisableHostedZoneDNSSECInput) (*route53.DisableHostedZoneDNSSECOutput, error)
+	DeactivateKeySigningKey(*route53.DeactivateKeySigningKeyInput) (*route53.DeactivateKeySigningKeyOutput, error)
+	DeleteKeySigningKey(*route53.DeleteKeySigningKeyInput) (*route53.DeleteKeySigningKeyOutput, error)
+
+	// Lambda
+	ListFunctions(*lambda.ListFunctionsInput) (*lambda.ListFunctionsOutput, error)
+	DeleteFunction(*lambda.DeleteFunctionInput) (*lambda.DeleteFunctionOutput, error)
+	ListLayers(*lambda.ListLayersInput) (*lambda.ListLayersOutput, error)
+	ListLayerVersions(*lambda.ListLayerVersionsInput) (*lambda.ListLayerVersionsOutput, error)
+	DeleteLayerVersion(*lambda.DeleteLayerVersionInput) (*lambda.DeleteLayerVersionOutput, error)
+	ListEventSourceMappings(*lambda.ListEventSourceMappingsInput) (*lambda.ListEventSourceMappingsOutput, error)
+	DeleteEventSourceMapping(*lambda.DeleteEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error)
+
+	// EventBridge
+	ListEventBuses(*eventbridge.ListEventBusesInput) (*eventbridge.ListEventBusesOutput, error)
+	DeleteEventBus(*eventbridge.DeleteEventBusInput) (*eventbridge.DeleteEventBusOutput, error)
+	ListRules(*eventbridge.ListRulesInput) (*eventbridge.ListRulesOutput, error)
+	DeleteRule(*eventbridge.DeleteRuleInput) (*eventbridge.DeleteRuleOutput, error)
+	ListTargetsByRule(*eventbridge.ListTargetsByRuleInput) (*eventbridge.ListTargetsByRuleOutput, error)
+	RemoveTargets(*eventbridge.RemoveTargetsInput) (*eventbridge.RemoveTargetsOutput, error)
+
+	// Step Functions
+	ListStateMachines(*sfn.ListStateMachinesInput) (*sfn.ListStateMachinesOutput, error)
+	DeleteStateMachine(*sfn.DeleteStateMachineInput) (*sfn.DeleteStateMachineOutput, error)
+
+	// ECR
+	DescribeRepositories(*ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error)
+	DeleteRepository(*ecr.DeleteRepositoryInput) (*ecr.DeleteRepositoryOutput, error)
+
+	// ECS
+	ListClustersECS(*ecs.ListClustersInput) (*ecs.ListClustersOutput, error)
+	DeleteClusterECS(*ecs.DeleteClusterInput) (*ecs.DeleteClusterOutput, error)
+	ListServices(*ecs.ListServicesInput) (*ecs.ListServicesOutput, error)
+	UpdateService(*ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error)
+	DeleteService(*ecs.DeleteServiceInput) (*ecs.DeleteServiceOutput, error)
+	ListTasks(*ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
+	StopTask(*ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
+	ListContainerInstances(*ecs.ListContainerInstancesInput) (*ecs.ListContainerInstancesOutput, error)
+	DeregisterContainerInstance(*ecs.DeregisterContainerInstanceInput) (*ecs.DeregisterContainerInstanceOutput, error)
+	ListTaskDefinitions(*ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error)
+	DeregisterTaskDefinition(*ecs.DeregisterTaskDefinitionInput) (*ecs.DeregisterTaskDefinitionOutput, error)
+
+	// EKS
+	ListClustersEKS(*eks.ListClustersInput) (*eks.ListClustersOutput, error)
+	DeleteClusterEKS(*eks.DeleteClusterInput) (*eks.DeleteClusterOutput, error)
+	ListNodegroups(*eks.ListNodegroupsInput) (*eks.ListNodegroupsOutput, error)
+	DeleteNodegroup(*eks.DeleteNodegroupInput) (*eks.DeleteNodegroupOutput, error)
+	ListFargateProfiles(*eks.ListFargateProfilesInput) (*eks.ListFargateProfilesOutput, error)
+	DeleteFargateProfile(*eks.DeleteFargateProfileInput) (*eks.DeleteFargateProfileOutput, error)
+
+	// CloudWatch Logs
+	DescribeLogGroups(*cloudwatchlogs.DescribeLogGroupsInput) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+	DeleteLogGroup(*cloudwatchlogs.DeleteLogGroupInput) (*cloudwatchlogs.DeleteLogGroupOutput, error)
+
+	// CloudWatch
+	DescribeAlarms(*cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error)
+	DeleteAlarms(*cloudwatch.DeleteAlarmsInput) (*cloudwatch.DeleteAlarmsOutput, error)
+	ListDashboards(*cloudwatch.ListDashboardsInput) (*cloudwatch.ListDashboardsOutput, error)
+	DeleteDashboards(*cloudwatch.DeleteDashboardsInput) (*cloudwatch.DeleteDashboardsOutput, error)
+	ListMetricStreams(*cloudwatch.ListMetricStreamsInput) (*cloudwatch.ListMetricStreamsOutput, error)
+	DeleteMetricStream(*cloudwatch.DeleteMetricStreamInput) (*cloudwatch.DeleteMetricStreamOutput, error)
+
+	// KMS
+	ListKeys(*kms.ListKeysInput) (*kms.ListKeysOutput, error)
+	DescribeKey(*kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error)
+	DisableKey(*kms.DisableKeyInput) (*kms.DisableKeyOutput, error)
+	ScheduleKeyDeletion(*kms.ScheduleKeyDeletionInput) (*kms.ScheduleKeyDeletionOutput, error)
+	ListAliases(*kms.ListAliasesInput) (*kms.ListAliasesOutput, error)
+	DeleteAlias(*kms.DeleteAliasInput) (*kms.DeleteAliasOutput, error)
+
+	// DynamoDB
+	ListTables(*dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error)
+	DeleteTable(*dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error)
+	ListBackups(*dynamodb.ListBackupsInput) (*dynamodb.ListBackupsOutput, error)
+	DeleteBackup(*dynamodb.DeleteBackupInput) (*dynamodb.DeleteBackupOutput, error)
+	DescribeTable(*dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error)
+	UpdateTable(*dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error)
+
+	// SQS
+	ListQueues(*sqs.ListQueuesInput) (*sqs.ListQueuesOutput, error)
+	DeleteQueue(*sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error)
+
+	// SNS
+	ListTopics(*sns.ListTopicsInput) (*sns.ListTopicsOutput, error)
+	DeleteTopic(*sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error)
+	ListSubscriptions(*sns.ListSubscriptionsInput) (*sns.ListSubscriptionsOutput, error)
+	Unsubscribe(*sns.UnsubscribeInput) (*sns.UnsubscribeOutput, error)
+
+	// Kinesis
+	ListStreams(*kinesis.ListStreamsInput) (*kinesis.ListStreamsOutput, error)
+	DeleteStream(*kinesis.DeleteStreamInput) (*kinesis.DeleteStreamOutput, error)
+
+	// Firehose
+	ListDeliveryStreams(*firehose.ListDeliveryStreamsInput) (*firehose.ListDeliveryStreamsOutput, error)
+	DeleteDeliveryStream(*firehose.DeleteDeliveryStreamInput) (*firehose.DeleteDeliveryStreamOutput, error)
+
+	// Secrets Manager
+	ListSecrets(*secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error)
+	DeleteSecret(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
+
+	// SSM
+	DescribeParameters(*ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error)
+	DeleteParameters(*ssm.DeleteParametersInput) (*ssm.DeleteParametersOutput, error)
+	ListDocuments(*ssm.ListDocumentsInput) (*ssm.ListDocumentsOutput, error)
+	DeleteDocument(*ssm.DeleteDocumentInput) (*ssm.DeleteDocumentOutput, error)
+	DescribeMaintenanceWindows(*ssm.DescribeMaintenanceWindowsInput) (*ssm.DescribeMaintenanceWindowsOutput, error)
+	DeleteMaintenanceWindow(*ssm.DeleteMaintenanceWindowInput) (*ssm.DeleteMaintenanceWindowOutput, error)
+
+	// ElastiCache
+	DescribeReplicationGroups(*elasticache.DescribeReplicationGroupsInput) (*elasticache.DescribeReplicationGroupsOutput, error)
+	DeleteReplicationGroup(*elasticache.DeleteReplicationGroupInput) (*elasticache.DeleteReplicationGroupOutput, error)
+	DescribeCacheClusters(*elasticache.DescribeCacheClustersInput) (*elasticache.DescribeCacheClustersOutput, error)
+	DeleteCacheCluster(*elasticache.DeleteCacheClusterInput) (*elasticache.DeleteCacheClusterOutput, error)
+	DescribeSnapshotsElastiCache(*elasticache.DescribeSnapshotsInput) (*elasticache.DescribeSnapshotsOutput, error)
+	DeleteSnapshotElastiCache(*elasticache.DeleteSnapshotInput) (*elasticache.DeleteSnapshotOutput, error)
+	DescribeCacheSubnetGroups(*elasticache.DescribeCacheSubnetGroupsInput) (*elasticache.DescribeCacheSubnetGroupsOutput, error)
+	DeleteCacheSubnetGroup(*elasticache.DeleteCacheSubnetGroupInput) (*elasticache.DeleteCacheSubnetGroupOutput, error)
+
+	// Elasticsearch (OpenSearch)
+	ListDomainNames(*elasticsearchservice.ListDomainNamesInput) (*elasticsearchservice.ListDomainNamesOutput, error)
+	DeleteElasticsearchDomain(*elasticsearchservice.DeleteElasticsearchDomainInput) (*elasticsearchservice.DeleteElasticsearchDomainOutput, error)
+
+	// Redshift
+	DescribeClustersRedshift(*redshift.DescribeClustersInput) (*redshift.DescribeClustersOutput, error)
+	DeleteClusterRedshift(*redshift.DeleteClusterInput) (*redshift.DeleteClusterOutput, error)
+	DescribeClusterSnapshots(*redshift.DescribeClusterSnapshotsInput) (*redshift.DescribeClusterSnapshotsOutput, error)
+	DeleteClusterSnapshot(*redshift.DeleteClusterSnapshotInput) (*redshift.DeleteClusterSnapshotOutput, error)
+	DescribeClusterSubnetGroups(*redshift.DescribeClusterSubnetGroupsInput) (*redshift.DescribeClusterSubnetGroupsOutput, error)
+	DeleteClusterSubnetGroup(*redshift.DeleteClusterSubnetGroupInput) (*redshift.DeleteClusterSubnetGroupOutput, error)
+
+	// S3 Control
+	ListAccessPoints(*s3control.ListAccessPointsInput) (*s3control.ListAccessPointsOutput, error)
+	DeleteAccessPoint(*s3control.DeleteAccessPointInput) (*s3control.DeleteAccessPointOutput, error)
+
+	// Route53 Resolver
+	ListResolverRuleAssociations(*route53resolver.ListResolverRuleAssociationsInput) (*route53resolver.ListResolverRuleAssociationsOutput, error)
+	DisassociateResolverRule(*route53resolver.DisassociateResolverRuleInput) (*route53resolver.DisassociateResolverRuleOutput, error)
+	ListResolverRules(*route53resolver.ListResolverRulesInput) (*route53resolver.ListResolverRulesOutput, error)
+	DeleteResolverRule(*route53resolver.DeleteResolverRuleInput) (*route53resolver.DeleteResolverRuleOutput, error)
+	ListResolverEndpoints(*route53resolver.ListResolverEndpointsInput) (*route53resolver.ListResolverEndpointsOutput, error)
+	DeleteResolverEndpoint(*route53resolver.DeleteResolverEndpointInput) (*route53resolver.DeleteResolverEndpointOutput, error)
+	ListResolverQueryLogConfigAssociations(*route53resolver.ListResolverQueryLogConfigAssociationsInput) (*route53resolver.ListResolverQueryLogConfigAssociationsOutput, error)
+	DisassociateResolverQueryLogConfig(*route53resolver.DisassociateResolverQueryLogConfigInput) (*route53resolver.DisassociateResolverQueryLogConfigOutput, error)
+	ListResolverQueryLogConfigs(*route53resolver.ListResolverQueryLogConfigsInput) (*route53resolver.ListResolverQueryLogConfigsOutput, error)
+	DeleteResolverQueryLogConfig(*route53resolver.DeleteResolverQueryLogConfigInput) (*route53resolver.DeleteResolverQueryLogConfigOutput, error)
+	ListFirewallRuleGroupAssociations(*route53resolver.ListFirewallRuleGroupAssociationsInput) (*route53resolver.ListFirewallRuleGroupAssociationsOutput, error)
+	UpdateFirewallRuleGroupAssociation(*route53resolver.UpdateFirewallRuleGroupAssociationInput) (*route53resolver.UpdateFirewallRuleGroupAssociationOutput, error)
+	DisassociateFirewallRuleGroup(*route53resolver.DisassociateFirewallRuleGroupInput) (*route53resolver.DisassociateFirewallRuleGroupOutput, error)
+	ListFirewallRuleGroups(*route53resolver.ListFirewallRuleGroupsInput) (*route53resolver.ListFirewallRuleGroupsOutput, error)
+	DeleteFirewallRuleGroup(*route53resolver.DeleteFirewallRuleGroupInput) (*route53resolver.DeleteFirewallRuleGroupOutput, error)
+
+	// CloudFront
+	ListDistributions(*cloudfront.ListDistributionsInput) (*cloudfront.ListDistributionsOutput, error)
+	GetDistributionConfig(*cloudfront.GetDistributionConfigInput) (*cloudfront.GetDistributionConfigOutput, error)
+	UpdateDistribution(*cloudfront.UpdateDistributionInput) (*cloudfront.UpdateDistributionOutput, error)
+	DeleteDistribution(*cloudfront.DeleteDistributionInput) (*cloudfront.DeleteDistributionOutput, error)
+
+	// ACM
+	ListCertificates(*acm.ListCertificatesInput) (*acm.ListCertificatesOutput, error)
+	DeleteCertificate(*acm.DeleteCertificateInput) (*acm.DeleteCertificateOutput, error)
+
+	// WAFv2
+	ListWebACLs(*wafv2.ListWebACLsInput) (*wafv2.ListWebACLsOutput, error)
+	DeleteWebACL(*wafv2.DeleteWebACLInput) (*wafv2.DeleteWebACLOutput, error)
+	ListIPSets(*wafv2.ListIPSetsInput) (*wafv2.ListIPSetsOutput, error)
+	DeleteIPSet(*wafv2.DeleteIPSetInput) (*wafv2.DeleteIPSetOutput, error)
+
+	// Global Accelerator
+	ListAccelerators(*globalaccelerator.ListAcceleratorsInput) (*globalaccelerator.ListAcceleratorsOutput, error)
+	UpdateAccelerator(*globalaccelerator.UpdateAcceleratorInput) (*globalaccelerator.UpdateAcceleratorOutput, error)
+	DeleteAccelerator(*globalaccelerator.DeleteAcceleratorInput) (*globalaccelerator.DeleteAcceleratorOutput, error)
+	ListListeners(*globalaccelerator.ListListenersInput) (*globalaccelerator.ListListenersOutput, error)
+	DeleteListener(*globalaccelerator.DeleteListenerInput) (*globalaccelerator.DeleteListenerOutput, error)
+	ListEndpointGroups(*globalaccelerator.ListEndpointGroupsInput) (*globalaccelerator.ListEndpointGroupsOutput, error)
+	DeleteEndpointGroup(*globalaccelerator.DeleteEndpointGroupInput) (*globalaccelerator.DeleteEndpointGroupOutput, error)
+
 	GetRegion() string
 }
 
 type awsClient struct {
-	region        string
-	ec2Client     ec2iface.EC2API
-	stsClient     stsiface.STSAPI
-	s3Client      s3iface.S3API
-	route53client route53iface.Route53API
-	elbClient     elbiface.ELBAPI
-	elbv2Client   elbv2iface.ELBV2API
-	efsClient     efsiface.EFSAPI
+	region           string
+	ec2Client        ec2iface.EC2API
+	stsClient        stsiface.STSAPI
+	s3Client         s3iface.S3API
+	route53client    route53iface.Route53API
+	elbClient        elbiface.ELBAPI
+	elbv2Client      elbv2iface.ELBV2API
+	efsClient        efsiface.EFSAPI
+	lambdaClient     lambdaiface.LambdaAPI
+	eventsClient     eventbridgeiface.EventBridgeAPI
+	sfnClient        sfniface.SFNAPI
+	ecrClient        ecriface.ECRAPI
+	ecsClient        ecsiface.ECSAPI
+	eksClient        eksiface.EKSAPI
+	logsClient       cloudwatchlogsiface.CloudWatchLogsAPI
+	cloudwatchClient cloudwatchiface.CloudWatchAPI
+	kmsClient        kmsiface.KMSAPI
+	dynamodbClient   dynamodbiface.DynamoDBAPI
+	sqsClient        sqsiface.SQSAPI
+	snsClient        snsiface.SNSAPI
+	kinesisClient    kinesisiface.KinesisAPI
+	firehoseClient   firehoseiface.FirehoseAPI
+	secretsClient    secretsmanageriface.SecretsManagerAPI
+	ssmClient        ssmiface.SSMAPI
+	cacheClient      elasticacheiface.ElastiCacheAPI
+	esClient         elasticsearchserviceiface.ElasticsearchServiceAPI
+	redshiftClient   redshiftiface.RedshiftAPI
+	s3controlClient  s3controliface.S3ControlAPI
+	resolverClient   route53resolveriface.Route53ResolverAPI
+	cloudfrontClient cloudfrontiface.CloudFrontAPI
+	acmClient        acmiface.ACMAPI
+	wafClient        wafv2iface.WAFV2API
+	gaClient         globalacceleratoriface.GlobalAcceleratorAPI
 }
 
 func (c *awsClient) DescribeInstanceStatus(input *ec2.DescribeInstanceStatusInput) (*ec2.DescribeInstanceStatusOutput, error) {
@@ -247,6 +572,190 @@ func (c *awsClient) ReleaseAddress(input *ec2.ReleaseAddressInput) (*ec2.Release
 	return c.ec2Client.ReleaseAddress(input)
 }
 
+func (c *awsClient) DescribeTransitGatewayAttachments(input *ec2.DescribeTransitGatewayAttachmentsInput) (*ec2.DescribeTransitGatewayAttachmentsOutput, error) {
+	return c.ec2Client.DescribeTransitGatewayAttachments(input)
+}
+
+func (c *awsClient) DeleteTransitGatewayVpcAttachment(input *ec2.DeleteTransitGatewayVpcAttachmentInput) (*ec2.DeleteTransitGatewayVpcAttachmentOutput, error) {
+	return c.ec2Client.DeleteTransitGatewayVpcAttachment(input)
+}
+
+func (c *awsClient) DeleteTransitGatewayPeeringAttachment(input *ec2.DeleteTransitGatewayPeeringAttachmentInput) (*ec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+	return c.ec2Client.DeleteTransitGatewayPeeringAttachment(input)
+}
+
+func (c *awsClient) DescribeTransitGatewayRouteTables(input *ec2.DescribeTransitGatewayRouteTablesInput) (*ec2.DescribeTransitGatewayRouteTablesOutput, error) {
+	return c.ec2Client.DescribeTransitGatewayRouteTables(input)
+}
+
+func (c *awsClient) DeleteTransitGatewayRouteTable(input *ec2.DeleteTransitGatewayRouteTableInput) (*ec2.DeleteTransitGatewayRouteTableOutput, error) {
+	return c.ec2Client.DeleteTransitGatewayRouteTable(input)
+}
+
+func (c *awsClient) DescribeTransitGateways(input *ec2.DescribeTransitGatewaysInput) (*ec2.DescribeTransitGatewaysOutput, error) {
+	return c.ec2Client.DescribeTransitGateways(input)
+}
+
+func (c *awsClient) DeleteTransitGateway(input *ec2.DeleteTransitGatewayInput) (*ec2.DeleteTransitGatewayOutput, error) {
+	return c.ec2Client.DeleteTransitGateway(input)
+}
+
+func (c *awsClient) DescribeVpcPeeringConnections(input *ec2.DescribeVpcPeeringConnectionsInput) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+	return c.ec2Client.DescribeVpcPeeringConnections(input)
+}
+
+func (c *awsClient) DeleteVpcPeeringConnection(input *ec2.DeleteVpcPeeringConnectionInput) (*ec2.DeleteVpcPeeringConnectionOutput, error) {
+	return c.ec2Client.DeleteVpcPeeringConnection(input)
+}
+
+func (c *awsClient) DescribeCustomerGateways(input *ec2.DescribeCustomerGatewaysInput) (*ec2.DescribeCustomerGatewaysOutput, error) {
+	return c.ec2Client.DescribeCustomerGateways(input)
+}
+
+func (c *awsClient) DeleteCustomerGateway(input *ec2.DeleteCustomerGatewayInput) (*ec2.DeleteCustomerGatewayOutput, error) {
+	return c.ec2Client.DeleteCustomerGateway(input)
+}
+
+func (c *awsClient) DescribeEgressOnlyInternetGateways(input *ec2.DescribeEgressOnlyInternetGatewaysInput) (*ec2.DescribeEgressOnlyInternetGatewaysOutput, error) {
+	return c.ec2Client.DescribeEgressOnlyInternetGateways(input)
+}
+
+func (c *awsClient) DeleteEgressOnlyInternetGateway(input *ec2.DeleteEgressOnlyInternetGatewayInput) (*ec2.DeleteEgressOnlyInternetGatewayOutput, error) {
+	return c.ec2Client.DeleteEgressOnlyInternetGateway(input)
+}
+
+func (c *awsClient) DescribeFlowLogs(input *ec2.DescribeFlowLogsInput) (*ec2.DescribeFlowLogsOutput, error) {
+	return c.ec2Client.DescribeFlowLogs(input)
+}
+
+func (c *awsClient) DeleteFlowLogs(input *ec2.DeleteFlowLogsInput) (*ec2.DeleteFlowLogsOutput, error) {
+	return c.ec2Client.DeleteFlowLogs(input)
+}
+
+func (c *awsClient) DisassociateVpcCidrBlock(input *ec2.DisassociateVpcCidrBlockInput) (*ec2.DisassociateVpcCidrBlockOutput, error) {
+	return c.ec2Client.DisassociateVpcCidrBlock(input)
+}
+
+func (c *awsClient) DescribeDhcpOptions(input *ec2.DescribeDhcpOptionsInput) (*ec2.DescribeDhcpOptionsOutput, error) {
+	return c.ec2Client.DescribeDhcpOptions(input)
+}
+
+func (c *awsClient) DeleteDhcpOptions(input *ec2.DeleteDhcpOptionsInput) (*ec2.DeleteDhcpOptionsOutput, error) {
+	return c.ec2Client.DeleteDhcpOptions(input)
+}
+
+func (c *awsClient) DescribeVpcEndpointServiceConfigurations(input *ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.DescribeVpcEndpointServiceConfigurationsOutput, error) {
+	return c.ec2Client.DescribeVpcEndpointServiceConfigurations(input)
+}
+
+func (c *awsClient) DeleteVpcEndpointServiceConfigurations(input *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+	return c.ec2Client.DeleteVpcEndpointServiceConfigurations(input)
+}
+
+func (c *awsClient) DescribeVpcEndpointConnections(input *ec2.DescribeVpcEndpointConnectionsInput) (*ec2.DescribeVpcEndpointConnectionsOutput, error) {
+	return c.ec2Client.DescribeVpcEndpointConnections(input)
+}
+
+func (c *awsClient) RejectVpcEndpointConnections(input *ec2.RejectVpcEndpointConnectionsInput) (*ec2.RejectVpcEndpointConnectionsOutput, error) {
+	return c.ec2Client.RejectVpcEndpointConnections(input)
+}
+
+func (c *awsClient) CreateDefaultVpc(input *ec2.CreateDefaultVpcInput) (*ec2.CreateDefaultVpcOutput, error) {
+	return c.ec2Client.CreateDefaultVpc(input)
+}
+
+func (c *awsClient) AssociateDhcpOptions(input *ec2.AssociateDhcpOptionsInput) (*ec2.AssociateDhcpOptionsOutput, error) {
+	return c.ec2Client.AssociateDhcpOptions(input)
+}
+
+func (c *awsClient) ReplaceNetworkAclAssociation(input *ec2.ReplaceNetworkAclAssociationInput) (*ec2.ReplaceNetworkAclAssociationOutput, error) {
+	return c.ec2Client.ReplaceNetworkAclAssociation(input)
+}
+
+func (c *awsClient) RevokeSecurityGroupEgress(input *ec2.RevokeSecurityGroupEgressInput) (*ec2.RevokeSecurityGroupEgressOutput, error) {
+	return c.ec2Client.RevokeSecurityGroupEgress(input)
+}
+
+func (c *awsClient) DeleteNetworkAclEntry(input *ec2.DeleteNetworkAclEntryInput) (*ec2.DeleteNetworkAclEntryOutput, error) {
+	return c.ec2Client.DeleteNetworkAclEntry(input)
+}
+
+func (c *awsClient) CreateNetworkAclEntry(input *ec2.CreateNetworkAclEntryInput) (*ec2.CreateNetworkAclEntryOutput, error) {
+	return c.ec2Client.CreateNetworkAclEntry(input)
+}
+
+func (c *awsClient) DeleteRoute(input *ec2.DeleteRouteInput) (*ec2.DeleteRouteOutput, error) {
+	return c.ec2Client.DeleteRoute(input)
+}
+
+func (c *awsClient) ModifyInstanceAttribute(input *ec2.ModifyInstanceAttributeInput) (*ec2.ModifyInstanceAttributeOutput, error) {
+	return c.ec2Client.ModifyInstanceAttribute(input)
+}
+
+func (c *awsClient) DescribeSpotInstanceRequests(input *ec2.DescribeSpotInstanceRequestsInput) (*ec2.DescribeSpotInstanceRequestsOutput, error) {
+	return c.ec2Client.DescribeSpotInstanceRequests(input)
+}
+
+func (c *awsClient) CancelSpotInstanceRequests(input *ec2.CancelSpotInstanceRequestsInput) (*ec2.CancelSpotInstanceRequestsOutput, error) {
+	return c.ec2Client.CancelSpotInstanceRequests(input)
+}
+
+func (c *awsClient) DescribeSpotFleetRequests(input *ec2.DescribeSpotFleetRequestsInput) (*ec2.DescribeSpotFleetRequestsOutput, error) {
+	return c.ec2Client.DescribeSpotFleetRequests(input)
+}
+
+func (c *awsClient) CancelSpotFleetRequests(input *ec2.CancelSpotFleetRequestsInput) (*ec2.CancelSpotFleetRequestsOutput, error) {
+	return c.ec2Client.CancelSpotFleetRequests(input)
+}
+
+func (c *awsClient) DescribeKeyPairs(input *ec2.DescribeKeyPairsInput) (*ec2.DescribeKeyPairsOutput, error) {
+	return c.ec2Client.DescribeKeyPairs(input)
+}
+
+func (c *awsClient) DeleteKeyPair(input *ec2.DeleteKeyPairInput) (*ec2.DeleteKeyPairOutput, error) {
+	return c.ec2Client.DeleteKeyPair(input)
+}
+
+func (c *awsClient) DescribePlacementGroups(input *ec2.DescribePlacementGroupsInput) (*ec2.DescribePlacementGroupsOutput, error) {
+	return c.ec2Client.DescribePlacementGroups(input)
+}
+
+func (c *awsClient) DeletePlacementGroup(input *ec2.DeletePlacementGroupInput) (*ec2.DeletePlacementGroupOutput, error) {
+	return c.ec2Client.DeletePlacementGroup(input)
+}
+
+func (c *awsClient) DescribeHosts(input *ec2.DescribeHostsInput) (*ec2.DescribeHostsOutput, error) {
+	return c.ec2Client.DescribeHosts(input)
+}
+
+func (c *awsClient) ReleaseHosts(input *ec2.ReleaseHostsInput) (*ec2.ReleaseHostsOutput, error) {
+	return c.ec2Client.ReleaseHosts(input)
+}
+
+func (c *awsClient) DescribeCapacityReservations(input *ec2.DescribeCapacityReservationsInput) (*ec2.DescribeCapacityReservationsOutput, error) {
+	return c.ec2Client.DescribeCapacityReservations(input)
+}
+
+func (c *awsClient) CancelCapacityReservation(input *ec2.CancelCapacityReservationInput) (*ec2.CancelCapacityReservationOutput, error) {
+	return c.ec2Client.CancelCapacityReservation(input)
+}
+
+func (c *awsClient) DescribeCarrierGateways(input *ec2.DescribeCarrierGatewaysInput) (*ec2.DescribeCarrierGatewaysOutput, error) {
+	return c.ec2Client.DescribeCarrierGateways(input)
+}
+
+func (c *awsClient) DeleteCarrierGateway(input *ec2.DeleteCarrierGatewayInput) (*ec2.DeleteCarrierGatewayOutput, error) {
+	return c.ec2Client.DeleteCarrierGateway(input)
+}
+
+func (c *awsClient) DescribeManagedPrefixLists(input *ec2.DescribeManagedPrefixListsInput) (*ec2.DescribeManagedPrefixListsOutput, error) {
+	return c.ec2Client.DescribeManagedPrefixLists(input)
+}
+
+func (c *awsClient) DeleteManagedPrefixList(input *ec2.DeleteManagedPrefixListInput) (*ec2.DeleteManagedPrefixListOutput, error) {
+	return c.ec2Client.DeleteManagedPrefixList(input)
+}
+
 //efs
 func (c *awsClient) DescribeMountTargets(input *efs.DescribeMountTargetsInput) (*efs.DescribeMountTargetsOutput, error) {
 	return c.efsClient.DescribeMountTargets(input)
@@ -280,6 +789,15 @@ func (c *awsClient) DescribeLoadBalancers2(input *elbv2.DescribeLoadBalancersInp
 func (c *awsClient) DeleteLoadBalancer2(input *elbv2.DeleteLoadBalancerInput) (*elbv2.DeleteLoadBalancerOutput, error) {
 	return c.elbv2Client.DeleteLoadBalancer(input)
 }
+func (c *awsClient) ModifyLoadBalancerAttributes2(input *elbv2.ModifyLoadBalancerAttributesInput) (*elbv2.ModifyLoadBalancerAttributesOutput, error) {
+	return c.elbv2Client.ModifyLoadBalancerAttributes(input)
+}
+func (c *awsClient) DescribeTargetGroups(input *elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error) {
+	return c.elbv2Client.DescribeTargetGroups(input)
+}
+func (c *awsClient) DeleteTargetGroup(input *elbv2.DeleteTargetGroupInput) (*elbv2.DeleteTargetGroupOutput, error) {
+	return c.elbv2Client.DeleteTargetGroup(input)
+}
 
 func (c *awsClient) AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
 	return c.stsClient.AssumeRole(input)
@@ -301,14 +819,68 @@ func (c *awsClient) ListObjectsV2(input *s3.ListObjectsV2Input) (*s3.ListObjects
 	return c.s3Client.ListObjectsV2(input)
 }
 
-func (c *awsClient) BatchDeleteBucketObjects(bucketName *string) error {
-	// Setup BatchDeleteItrerator to iterate through a list of objects
-	iter := s3manager.NewDeleteListIterator(c.s3Client, &s3.ListObjectsInput{
-		Bucket: bucketName,
-	})
+func (c *awsClient) ListObjectVersions(input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error) {
+	return c.s3Client.ListObjectVersions(input)
+}
+
+func (c *awsClient) DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+	return c.s3Client.DeleteObjects(input)
+}
 
-	// Traverse iterator deleting each object
-	return s3manager.NewBatchDeleteWithClient(c.s3Client).Delete(aws.BackgroundContext(), iter)
+func (c *awsClient) ListMultipartUploads(input *s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error) {
+	return c.s3Client.ListMultipartUploads(input)
+}
+
+func (c *awsClient) AbortMultipartUpload(input *s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error) {
+	return c.s3Client.AbortMultipartUpload(input)
+}
+
+func (c *awsClient) GetBucketVersioning(input *s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error) {
+	return c.s3Client.GetBucketVersioning(input)
+}
+
+func (c *awsClient) PutBucketVersioning(input *s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error) {
+	return c.s3Client.PutBucketVersioning(input)
+}
+
+func (c *awsClient) GetBucketPolicy(input *s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error) {
+	return c.s3Client.GetBucketPolicy(input)
+}
+
+func (c *awsClient) DeleteBucketPolicy(input *s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error) {
+	return c.s3Client.DeleteBucketPolicy(input)
+}
+
+func (c *awsClient) GetBucketLocation(input *s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error) {
+	return c.s3Client.GetBucketLocation(input)
+}
+
+func (c *awsClient) DeleteBucketReplication(input *s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error) {
+	return c.s3Client.DeleteBucketReplication(input)
+}
+
+func (c *awsClient) DeleteBucketLifecycle(input *s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error) {
+	return c.s3Client.DeleteBucketLifecycle(input)
+}
+
+func (c *awsClient) ListBucketInventoryConfigurations(input *s3.ListBucketInventoryConfigurationsInput) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+	return c.s3Client.ListBucketInventoryConfigurations(input)
+}
+
+func (c *awsClient) DeleteBucketInventoryConfiguration(input *s3.DeleteBucketInventoryConfigurationInput) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+	return c.s3Client.DeleteBucketInventoryConfiguration(input)
+}
+
+func (c *awsClient) GetObjectLockConfiguration(input *s3.GetObjectLockConfigurationInput) (*s3.GetObjectLockConfigurationOutput, error) {
+	return c.s3Client.GetObjectLockConfiguration(input)
+}
+
+func (c *awsClient) PutObjectLegalHold(input *s3.PutObjectLegalHoldInput) (*s3.PutObjectLegalHoldOutput, error) {
+	return c.s3Client.PutObjectLegalHold(input)
+}
+
+func (c *awsClient) GetObjectRetention(input *s3.GetObjectRetentionInput) (*s3.GetObjectRetentionOutput, error) {
+	return c.s3Client.GetObjectRetention(input)
 }
 
 func (c *awsClient) ListHostedZones(input *route53.ListHostedZonesInput) (*route53.ListHostedZonesOutput, error) {
@@ -327,6 +899,575 @@ func (c *awsClient) ChangeResourceRecordSets(input *route53.ChangeResourceRecord
 	return c.route53client.ChangeResourceRecordSets(input)
 }
 
+func (c *awsClient) GetHostedZone(input *route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error) {
+	return c.route53client.GetHostedZone(input)
+}
+
+func (c *awsClient) DisassociateVPCFromHostedZone(input *route53.DisassociateVPCFromHostedZoneInput) (*route53.DisassociateVPCFromHostedZoneOutput, error) {
+	return c.route53client.DisassociateVPCFromHostedZone(input)
+}
+
+func (c *awsClient) ListVPCAssociationAuthorizations(input *route53.ListVPCAssociationAuthorizationsInput) (*route53.ListVPCAssociationAuthorizationsOutput, error) {
+	return c.route53client.ListVPCAssociationAuthorizations(input)
+}
+
+func (c *awsClient) DeleteVPCAssociationAuthorization(input *route53.DeleteVPCAssociationAuthorizationInput) (*route53.DeleteVPCAssociationAuthorizationOutput, error) {
+	return c.route53client.DeleteVPCAssociationAuthorization(input)
+}
+
+func (c *awsClient) ListQueryLoggingConfigs(input *route53.ListQueryLoggingConfigsInput) (*route53.ListQueryLoggingConfigsOutput, error) {
+	return c.route53client.ListQueryLoggingConfigs(input)
+}
+
+func (c *awsClient) DeleteQueryLoggingConfig(input *route53.DeleteQueryLoggingConfigInput) (*route53.DeleteQueryLoggingConfigOutput, error) {
+	return c.route53client.DeleteQueryLoggingConfig(input)
+}
+
+func (c *awsClient) ListHealthChecks(input *route53.ListHealthChecksInput) (*route53.ListHealthChecksOutput, error) {
+	return c.route53client.ListHealthChecks(input)
+}
+
+func (c *awsClient) DeleteHealthCheck(input *route53.DeleteHealthCheckInput) (*route53.DeleteHealthCheckOutput, error) {
+	return c.route53client.DeleteHealthCheck(input)
+}
+
+func (c *awsClient) ListTrafficPolicyInstances(input *route53.ListTrafficPolicyInstancesInput) (*route53.ListTrafficPolicyInstancesOutput, error) {
+	return c.route53client.ListTrafficPolicyInstances(input)
+}
+
+func (c *awsClient) DeleteTrafficPolicyInstance(input *route53.DeleteTrafficPolicyInstanceInput) (*route53.DeleteTrafficPolicyInstanceOutput, error) {
+	return c.route53client.DeleteTrafficPolicyInstance(input)
+}
+
+func (c *awsClient) GetDNSSEC(input *route53.GetDNSSECInput) (*route53.GetDNSSECOutput, error) {
+	return c.route53client.GetDNSSEC(input)
+}
+
+func (c *awsClient) DisableHostedZoneDNSSEC(input *route53.DisableHostedZoneDNSSECInput) (*route53.DisableHostedZoneDNSSECOutput, error) {
+	return c.route53client.DisableHostedZoneDNSSEC(input)
+}
+
+func (c *awsClient) DeactivateKeySigningKey(input *route53.DeactivateKeySigningKeyInput) (*route53.DeactivateKeySigningKeyOutput, error) {
+	return c.route53client.DeactivateKeySigningKey(input)
+}
+
+func (c *awsClient) DeleteKeySigningKey(input *route53.DeleteKeySigningKeyInput) (*route53.DeleteKeySigningKeyOutput, error) {
+	return c.route53client.DeleteKeySigningKey(input)
+}
+
+// Lambda
+func (c *awsClient) ListFunctions(input *lambda.ListFunctionsInput) (*lambda.ListFunctionsOutput, error) {
+	return c.lambdaClient.ListFunctions(input)
+}
+
+func (c *awsClient) DeleteFunction(input *lambda.DeleteFunctionInput) (*lambda.DeleteFunctionOutput, error) {
+	return c.lambdaClient.DeleteFunction(input)
+}
+
+func (c *awsClient) ListLayers(input *lambda.ListLayersInput) (*lambda.ListLayersOutput, error) {
+	return c.lambdaClient.ListLayers(input)
+}
+
+func (c *awsClient) ListLayerVersions(input *lambda.ListLayerVersionsInput) (*lambda.ListLayerVersionsOutput, error) {
+	return c.lambdaClient.ListLayerVersions(input)
+}
+
+func (c *awsClient) DeleteLayerVersion(input *lambda.DeleteLayerVersionInput) (*lambda.DeleteLayerVersionOutput, error) {
+	return c.lambdaClient.DeleteLayerVersion(input)
+}
+
+func (c *awsClient) ListEventSourceMappings(input *lambda.ListEventSourceMappingsInput) (*lambda.ListEventSourceMappingsOutput, error) {
+	return c.lambdaClient.ListEventSourceMappings(input)
+}
+
+func (c *awsClient) DeleteEventSourceMapping(input *lambda.DeleteEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
+	return c.lambdaClient.DeleteEventSourceMapping(input)
+}
+
+// EventBridge
+func (c *awsClient) ListEventBuses(input *eventbridge.ListEventBusesInput) (*eventbridge.ListEventBusesOutput, error) {
+	return c.eventsClient.ListEventBuses(input)
+}
+
+func (c *awsClient) DeleteEventBus(input *eventbridge.DeleteEventBusInput) (*eventbridge.DeleteEventBusOutput, error) {
+	return c.eventsClient.DeleteEventBus(input)
+}
+
+func (c *awsClient) ListRules(input *eventbridge.ListRulesInput) (*eventbridge.ListRulesOutput, error) {
+	return c.eventsClient.ListRules(input)
+}
+
+func (c *awsClient) DeleteRule(input *eventbridge.DeleteRuleInput) (*eventbridge.DeleteRuleOutput, error) {
+	return c.eventsClient.DeleteRule(input)
+}
+
+func (c *awsClient) ListTargetsByRule(input *eventbridge.ListTargetsByRuleInput) (*eventbridge.ListTargetsByRuleOutput, error) {
+	return c.eventsClient.ListTargetsByRule(input)
+}
+
+func (c *awsClient) RemoveTargets(input *eventbridge.RemoveTargetsInput) (*eventbridge.RemoveTargetsOutput, error) {
+	return c.eventsClient.RemoveTargets(input)
+}
+
+// Step Functions
+func (c *awsClient) ListStateMachines(input *sfn.ListStateMachinesInput) (*sfn.ListStateMachinesOutput, error) {
+	return c.sfnClient.ListStateMachines(input)
+}
+
+func (c *awsClient) DeleteStateMachine(input *sfn.DeleteStateMachineInput) (*sfn.DeleteStateMachineOutput, error) {
+	return c.sfnClient.DeleteStateMachine(input)
+}
+
+// ECR
+func (c *awsClient) DescribeRepositories(input *ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error) {
+	return c.ecrClient.DescribeRepositories(input)
+}
+
+func (c *awsClient) DeleteRepository(input *ecr.DeleteRepositoryInput) (*ecr.DeleteRepositoryOutput, error) {
+	return c.ecrClient.DeleteRepository(input)
+}
+
+// ECS
+func (c *awsClient) ListClustersECS(input *ecs.ListClustersInput) (*ecs.ListClustersOutput, error) {
+	return c.ecsClient.ListClusters(input)
+}
+
+func (c *awsClient) DeleteClusterECS(input *ecs.DeleteClusterInput) (*ecs.DeleteClusterOutput, error) {
+	return c.ecsClient.DeleteCluster(input)
+}
+
+func (c *awsClient) ListServices(input *ecs.ListServicesInput) (*ecs.ListServicesOutput, error) {
+	return c.ecsClient.ListServices(input)
+}
+
+func (c *awsClient) UpdateService(input *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error) {
+	return c.ecsClient.UpdateService(input)
+}
+
+func (c *awsClient) DeleteService(input *ecs.DeleteServiceInput) (*ecs.DeleteServiceOutput, error) {
+	return c.ecsClient.DeleteService(input)
+}
+
+func (c *awsClient) ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error) {
+	return c.ecsClient.ListTasks(input)
+}
+
+func (c *awsClient) StopTask(input *ecs.StopTaskInput) (*ecs.StopTaskOutput, error) {
+	return c.ecsClient.StopTask(input)
+}
+
+func (c *awsClient) ListContainerInstances(input *ecs.ListContainerInstancesInput) (*ecs.ListContainerInstancesOutput, error) {
+	return c.ecsClient.ListContainerInstances(input)
+}
+
+func (c *awsClient) DeregisterContainerInstance(input *ecs.DeregisterContainerInstanceInput) (*ecs.DeregisterContainerInstanceOutput, error) {
+	return c.ecsClient.DeregisterContainerInstance(input)
+}
+
+func (c *awsClient) ListTaskDefinitions(input *ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error) {
+	return c.ecsClient.ListTaskDefinitions(input)
+}
+
+func (c *awsClient) DeregisterTaskDefinition(input *ecs.DeregisterTaskDefinitionInput) (*ecs.DeregisterTaskDefinitionOutput, error) {
+	return c.ecsClient.DeregisterTaskDefinition(input)
+}
+
+// EKS
+func (c *awsClient) ListClustersEKS(input *eks.ListClustersInput) (*eks.ListClustersOutput, error) {
+	return c.eksClient.ListClusters(input)
+}
+
+func (c *awsClient) DeleteClusterEKS(input *eks.DeleteClusterInput) (*eks.DeleteClusterOutput, error) {
+	return c.eksClient.DeleteCluster(input)
+}
+
+func (c *awsClient) ListNodegroups(input *eks.ListNodegroupsInput) (*eks.ListNodegroupsOutput, error) {
+	return c.eksClient.ListNodegroups(input)
+}
+
+func (c *awsClient) DeleteNodegroup(input *eks.DeleteNodegroupInput) (*eks.DeleteNodegroupOutput, error) {
+	return c.eksClient.DeleteNodegroup(input)
+}
+
+func (c *awsClient) ListFargateProfiles(input *eks.ListFargateProfilesInput) (*eks.ListFargateProfilesOutput, error) {
+	return c.eksClient.ListFargateProfiles(input)
+}
+
+func (c *awsClient) DeleteFargateProfile(input *eks.DeleteFargateProfileInput) (*eks.DeleteFargateProfileOutput, error) {
+	return c.eksClient.DeleteFargateProfile(input)
+}
+
+// CloudWatch Logs
+func (c *awsClient) DescribeLogGroups(input *cloudwatchlogs.DescribeLogGroupsInput) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	return c.logsClient.DescribeLogGroups(input)
+}
+
+func (c *awsClient) DeleteLogGroup(input *cloudwatchlogs.DeleteLogGroupInput) (*cloudwatchlogs.DeleteLogGroupOutput, error) {
+	return c.logsClient.DeleteLogGroup(input)
+}
+
+// CloudWatch
+func (c *awsClient) DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error) {
+	return c.cloudwatchClient.DescribeAlarms(input)
+}
+
+func (c *awsClient) DeleteAlarms(input *cloudwatch.DeleteAlarmsInput) (*cloudwatch.DeleteAlarmsOutput, error) {
+	return c.cloudwatchClient.DeleteAlarms(input)
+}
+
+func (c *awsClient) ListDashboards(input *cloudwatch.ListDashboardsInput) (*cloudwatch.ListDashboardsOutput, error) {
+	return c.cloudwatchClient.ListDashboards(input)
+}
+
+func (c *awsClient) DeleteDashboards(input *cloudwatch.DeleteDashboardsInput) (*cloudwatch.DeleteDashboardsOutput, error) {
+	return c.cloudwatchClient.DeleteDashboards(input)
+}
+
+func (c *awsClient) ListMetricStreams(input *cloudwatch.ListMetricStreamsInput) (*cloudwatch.ListMetricStreamsOutput, error) {
+	return c.cloudwatchClient.ListMetricStreams(input)
+}
+
+func (c *awsClient) DeleteMetricStream(input *cloudwatch.DeleteMetricStreamInput) (*cloudwatch.DeleteMetricStreamOutput, error) {
+	return c.cloudwatchClient.DeleteMetricStream(input)
+}
+
+// KMS
+func (c *awsClient) ListKeys(input *kms.ListKeysInput) (*kms.ListKeysOutput, error) {
+	return c.kmsClient.ListKeys(input)
+}
+
+func (c *awsClient) DescribeKey(input *kms.DescribeKeyInput) (*kms.DescribeKeyOutput, error) {
+	return c.kmsClient.DescribeKey(input)
+}
+
+func (c *awsClient) DisableKey(input *kms.DisableKeyInput) (*kms.DisableKeyOutput, error) {
+	return c.kmsClient.DisableKey(input)
+}
+
+func (c *awsClient) ScheduleKeyDeletion(input *kms.ScheduleKeyDeletionInput) (*kms.ScheduleKeyDeletionOutput, error) {
+	return c.kmsClient.ScheduleKeyDeletion(input)
+}
+
+func (c *awsClient) ListAliases(input *kms.ListAliasesInput) (*kms.ListAliasesOutput, error) {
+	return c.kmsClient.ListAliases(input)
+}
+
+func (c *awsClient) DeleteAlias(input *kms.DeleteAliasInput) (*kms.DeleteAliasOutput, error) {
+	return c.kmsClient.DeleteAlias(input)
+}
+
+// DynamoDB
+func (c *awsClient) ListTables(input *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error) {
+	return c.dynamodbClient.ListTables(input)
+}
+
+func (c *awsClient) DeleteTable(input *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+	return c.dynamodbClient.DeleteTable(input)
+}
+
+func (c *awsClient) ListBackups(input *dynamodb.ListBackupsInput) (*dynamodb.ListBackupsOutput, error) {
+	return c.dynamodbClient.ListBackups(input)
+}
+
+func (c *awsClient) DeleteBackup(input *dynamodb.DeleteBackupInput) (*dynamodb.DeleteBackupOutput, error) {
+	return c.dynamodbClient.DeleteBackup(input)
+}
+
+func (c *awsClient) DescribeTable(input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	return c.dynamodbClient.DescribeTable(input)
+}
+
+func (c *awsClient) UpdateTable(input *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
+	return c.dynamodbClient.UpdateTable(input)
+}
+
+// SQS
+func (c *awsClient) ListQueues(input *sqs.ListQueuesInput) (*sqs.ListQueuesOutput, error) {
+	return c.sqsClient.ListQueues(input)
+}
+
+func (c *awsClient) DeleteQueue(input *sqs.DeleteQueueInput) (*sqs.DeleteQueueOutput, error) {
+	return c.sqsClient.DeleteQueue(input)
+}
+
+// SNS
+func (c *awsClient) ListTopics(input *sns.ListTopicsInput) (*sns.ListTopicsOutput, error) {
+	return c.snsClient.ListTopics(input)
+}
+
+func (c *awsClient) DeleteTopic(input *sns.DeleteTopicInput) (*sns.DeleteTopicOutput, error) {
+	return c.snsClient.DeleteTopic(input)
+}
+
+func (c *awsClient) ListSubscriptions(input *sns.ListSubscriptionsInput) (*sns.ListSubscriptionsOutput, error) {
+	return c.snsClient.ListSubscriptions(input)
+}
+
+func (c *awsClient) Unsubscribe(input *sns.UnsubscribeInput) (*sns.UnsubscribeOutput, error) {
+	return c.snsClient.Unsubscribe(input)
+}
+
+// Kinesis
+func (c *awsClient) ListStreams(input *kinesis.ListStreamsInput) (*kinesis.ListStreamsOutput, error) {
+	return c.kinesisClient.ListStreams(input)
+}
+
+func (c *awsClient) DeleteStream(input *kinesis.DeleteStreamInput) (*kinesis.DeleteStreamOutput, error) {
+	return c.kinesisClient.DeleteStream(input)
+}
+
+// Firehose
+func (c *awsClient) ListDeliveryStreams(input *firehose.ListDeliveryStreamsInput) (*firehose.ListDeliveryStreamsOutput, error) {
+	return c.firehoseClient.ListDeliveryStreams(input)
+}
+
+func (c *awsClient) DeleteDeliveryStream(input *firehose.DeleteDeliveryStreamInput) (*firehose.DeleteDeliveryStreamOutput, error) {
+	return c.firehoseClient.DeleteDeliveryStream(input)
+}
+
+// Secrets Manager
+func (c *awsClient) ListSecrets(input *secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error) {
+	return c.secretsClient.ListSecrets(input)
+}
+
+func (c *awsClient) DeleteSecret(input *secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error) {
+	return c.secretsClient.DeleteSecret(input)
+}
+
+// SSM
+func (c *awsClient) DescribeParameters(input *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error) {
+	return c.ssmClient.DescribeParameters(input)
+}
+
+func (c *awsClient) DeleteParameters(input *ssm.DeleteParametersInput) (*ssm.DeleteParametersOutput, error) {
+	return c.ssmClient.DeleteParameters(input)
+}
+
+func (c *awsClient) ListDocuments(input *ssm.ListDocumentsInput) (*ssm.ListDocumentsOutput, error) {
+	return c.ssmClient.ListDocuments(input)
+}
+
+func (c *awsClient) DeleteDocument(input *ssm.DeleteDocumentInput) (*ssm.DeleteDocumentOutput, error) {
+	return c.ssmClient.DeleteDocument(input)
+}
+
+func (c *awsClient) DescribeMaintenanceWindows(input *ssm.DescribeMaintenanceWindowsInput) (*ssm.DescribeMaintenanceWindowsOutput, error) {
+	return c.ssmClient.DescribeMaintenanceWindows(input)
+}
+
+func (c *awsClient) DeleteMaintenanceWindow(input *ssm.DeleteMaintenanceWindowInput) (*ssm.DeleteMaintenanceWindowOutput, error) {
+	return c.ssmClient.DeleteMaintenanceWindow(input)
+}
+
+// ElastiCache
+func (c *awsClient) DescribeReplicationGroups(input *elasticache.DescribeReplicationGroupsInput) (*elasticache.DescribeReplicationGroupsOutput, error) {
+	return c.cacheClient.DescribeReplicationGroups(input)
+}
+
+func (c *awsClient) DeleteReplicationGroup(input *elasticache.DeleteReplicationGroupInput) (*elasticache.DeleteReplicationGroupOutput, error) {
+	return c.cacheClient.DeleteReplicationGroup(input)
+}
+
+func (c *awsClient) DescribeCacheClusters(input *elasticache.DescribeCacheClustersInput) (*elasticache.DescribeCacheClustersOutput, error) {
+	return c.cacheClient.DescribeCacheClusters(input)
+}
+
+func (c *awsClient) DeleteCacheCluster(input *elasticache.DeleteCacheClusterInput) (*elasticache.DeleteCacheClusterOutput, error) {
+	return c.cacheClient.DeleteCacheCluster(input)
+}
+
+func (c *awsClient) DescribeSnapshotsElastiCache(input *elasticache.DescribeSnapshotsInput) (*elasticache.DescribeSnapshotsOutput, error) {
+	return c.cacheClient.DescribeSnapshots(input)
+}
+
+func (c *awsClient) DeleteSnapshotElastiCache(input *elasticache.DeleteSnapshotInput) (*elasticache.DeleteSnapshotOutput, error) {
+	return c.cacheClient.DeleteSnapshot(input)
+}
+
+func (c *awsClient) DescribeCacheSubnetGroups(input *elasticache.DescribeCacheSubnetGroupsInput) (*elasticache.DescribeCacheSubnetGroupsOutput, error) {
+	return c.cacheClient.DescribeCacheSubnetGroups(input)
+}
+
+func (c *awsClient) DeleteCacheSubnetGroup(input *elasticache.DeleteCacheSubnetGroupInput) (*elasticache.DeleteCacheSubnetGroupOutput, error) {
+	return c.cacheClient.DeleteCacheSubnetGroup(input)
+}
+
+// Elasticsearch (OpenSearch)
+func (c *awsClient) ListDomainNames(input *elasticsearchservice.ListDomainNamesInput) (*elasticsearchservice.ListDomainNamesOutput, error) {
+	return c.esClient.ListDomainNames(input)
+}
+
+func (c *awsClient) DeleteElasticsearchDomain(input *elasticsearchservice.DeleteElasticsearchDomainInput) (*elasticsearchservice.DeleteElasticsearchDomainOutput, error) {
+	return c.esClient.DeleteElasticsearchDomain(input)
+}
+
+// Redshift
+func (c *awsClient) DescribeClustersRedshift(input *redshift.DescribeClustersInput) (*redshift.DescribeClustersOutput, error) {
+	return c.redshiftClient.DescribeClusters(input)
+}
+
+func (c *awsClient) DeleteClusterRedshift(input *redshift.DeleteClusterInput) (*redshift.DeleteClusterOutput, error) {
+	return c.redshiftClient.DeleteCluster(input)
+}
+
+func (c *awsClient) DescribeClusterSnapshots(input *redshift.DescribeClusterSnapshotsInput) (*redshift.DescribeClusterSnapshotsOutput, error) {
+	return c.redshiftClient.DescribeClusterSnapshots(input)
+}
+
+func (c *awsClient) DeleteClusterSnapshot(input *redshift.DeleteClusterSnapshotInput) (*redshift.DeleteClusterSnapshotOutput, error) {
+	return c.redshiftClient.DeleteClusterSnapshot(input)
+}
+
+func (c *awsClient) DescribeClusterSubnetGroups(input *redshift.DescribeClusterSubnetGroupsInput) (*redshift.DescribeClusterSubnetGroupsOutput, error) {
+	return c.redshiftClient.DescribeClusterSubnetGroups(input)
+}
+
+func (c *awsClient) DeleteClusterSubnetGroup(input *redshift.DeleteClusterSubnetGroupInput) (*redshift.DeleteClusterSubnetGroupOutput, error) {
+	return c.redshiftClient.DeleteClusterSubnetGroup(input)
+}
+
+// S3 Control
+func (c *awsClient) ListAccessPoints(input *s3control.ListAccessPointsInput) (*s3control.ListAccessPointsOutput, error) {
+	return c.s3controlClient.ListAccessPoints(input)
+}
+
+func (c *awsClient) DeleteAccessPoint(input *s3control.DeleteAccessPointInput) (*s3control.DeleteAccessPointOutput, error) {
+	return c.s3controlClient.DeleteAccessPoint(input)
+}
+
+// Route53 Resolver
+func (c *awsClient) ListResolverRuleAssociations(input *route53resolver.ListResolverRuleAssociationsInput) (*route53resolver.ListResolverRuleAssociationsOutput, error) {
+	return c.resolverClient.ListResolverRuleAssociations(input)
+}
+
+func (c *awsClient) DisassociateResolverRule(input *route53resolver.DisassociateResolverRuleInput) (*route53resolver.DisassociateResolverRuleOutput, error) {
+	return c.resolverClient.DisassociateResolverRule(input)
+}
+
+func (c *awsClient) ListResolverRules(input *route53resolver.ListResolverRulesInput) (*route53resolver.ListResolverRulesOutput, error) {
+	return c.resolverClient.ListResolverRules(input)
+}
+
+func (c *awsClient) DeleteResolverRule(input *route53resolver.DeleteResolverRuleInput) (*route53resolver.DeleteResolverRuleOutput, error) {
+	return c.resolverClient.DeleteResolverRule(input)
+}
+
+func (c *awsClient) ListResolverEndpoints(input *route53resolver.ListResolverEndpointsInput) (*route53resolver.ListResolverEndpointsOutput, error) {
+	return c.resolverClient.ListResolverEndpoints(input)
+}
+
+func (c *awsClient) DeleteResolverEndpoint(input *route53resolver.DeleteResolverEndpointInput) (*route53resolver.DeleteResolverEndpointOutput, error) {
+	return c.resolverClient.DeleteResolverEndpoint(input)
+}
+
+func (c *awsClient) ListResolverQueryLogConfigAssociations(input *route53resolver.ListResolverQueryLogConfigAssociationsInput) (*route53resolver.ListResolverQueryLogConfigAssociationsOutput, error) {
+	return c.resolverClient.ListResolverQueryLogConfigAssociations(input)
+}
+
+func (c *awsClient) DisassociateResolverQueryLogConfig(input *route53resolver.DisassociateResolverQueryLogConfigInput) (*route53resolver.DisassociateResolverQueryLogConfigOutput, error) {
+	return c.resolverClient.DisassociateResolverQueryLogConfig(input)
+}
+
+func (c *awsClient) ListResolverQueryLogConfigs(input *route53resolver.ListResolverQueryLogConfigsInput) (*route53resolver.ListResolverQueryLogConfigsOutput, error) {
+	return c.resolverClient.ListResolverQueryLogConfigs(input)
+}
+
+func (c *awsClient) DeleteResolverQueryLogConfig(input *route53resolver.DeleteResolverQueryLogConfigInput) (*route53resolver.DeleteResolverQueryLogConfigOutput, error) {
+	return c.resolverClient.DeleteResolverQueryLogConfig(input)
+}
+
+func (c *awsClient) ListFirewallRuleGroupAssociations(input *route53resolver.ListFirewallRuleGroupAssociationsInput) (*route53resolver.ListFirewallRuleGroupAssociationsOutput, error) {
+	return c.resolverClient.ListFirewallRuleGroupAssociations(input)
+}
+
+func (c *awsClient) UpdateFirewallRuleGroupAssociation(input *route53resolver.UpdateFirewallRuleGroupAssociationInput) (*route53resolver.UpdateFirewallRuleGroupAssociationOutput, error) {
+	return c.resolverClient.UpdateFirewallRuleGroupAssociation(input)
+}
+
+func (c *awsClient) DisassociateFirewallRuleGroup(input *route53resolver.DisassociateFirewallRuleGroupInput) (*route53resolver.DisassociateFirewallRuleGroupOutput, error) {
+	return c.resolverClient.DisassociateFirewallRuleGroup(input)
+}
+
+func (c *awsClient) ListFirewallRuleGroups(input *route53resolver.ListFirewallRuleGroupsInput) (*route53resolver.ListFirewallRuleGroupsOutput, error) {
+	return c.resolverClient.ListFirewallRuleGroups(input)
+}
+
+func (c *awsClient) DeleteFirewallRuleGroup(input *route53resolver.DeleteFirewallRuleGroupInput) (*route53resolver.DeleteFirewallRuleGroupOutput, error) {
+	return c.resolverClient.DeleteFirewallRuleGroup(input)
+}
+
+// CloudFront
+func (c *awsClient) ListDistributions(input *cloudfront.ListDistributionsInput) (*cloudfront.ListDistributionsOutput, error) {
+	return c.cloudfrontClient.ListDistributions(input)
+}
+
+func (c *awsClient) GetDistributionConfig(input *cloudfront.GetDistributionConfigInput) (*cloudfront.GetDistributionConfigOutput, error) {
+	return c.cloudfrontClient.GetDistributionConfig(input)
+}
+
+func (c *awsClient) UpdateDistribution(input *cloudfront.UpdateDistributionInput) (*cloudfront.UpdateDistributionOutput, error) {
+	return c.cloudfrontClient.UpdateDistribution(input)
+}
+
+func (c *awsClient) DeleteDistribution(input *cloudfront.DeleteDistributionInput) (*cloudfront.DeleteDistributionOutput, error) {
+	return c.cloudfrontClient.DeleteDistribution(input)
+}
+
+// ACM
+func (c *awsClient) ListCertificates(input *acm.ListCertificatesInput) (*acm.ListCertificatesOutput, error) {
+	return c.acmClient.ListCertificates(input)
+}
+
+func (c *awsClient) DeleteCertificate(input *acm.DeleteCertificateInput) (*acm.DeleteCertificateOutput, error) {
+	return c.acmClient.DeleteCertificate(input)
+}
+
+// WAFv2
+func (c *awsClient) ListWebACLs(input *wafv2.ListWebACLsInput) (*wafv2.ListWebACLsOutput, error) {
+	return c.wafClient.ListWebACLs(input)
+}
+
+func (c *awsClient) DeleteWebACL(input *wafv2.DeleteWebACLInput) (*wafv2.DeleteWebACLOutput, error) {
+	return c.wafClient.DeleteWebACL(input)
+}
+
+func (c *awsClient) ListIPSets(input *wafv2.ListIPSetsInput) (*wafv2.ListIPSetsOutput, error) {
+	return c.wafClient.ListIPSets(input)
+}
+
+func (c *awsClient) DeleteIPSet(input *wafv2.DeleteIPSetInput) (*wafv2.DeleteIPSetOutput, error) {
+	return c.wafClient.DeleteIPSet(input)
+}
+
+// Global Accelerator
+func (c *awsClient) ListAccelerators(input *globalaccelerator.ListAcceleratorsInput) (*globalaccelerator.ListAcceleratorsOutput, error) {
+	return c.gaClient.ListAccelerators(input)
+}
+
+func (c *awsClient) UpdateAccelerator(input *globalaccelerator.UpdateAcceleratorInput) (*globalaccelerator.UpdateAcceleratorOutput, error) {
+	return c.gaClient.UpdateAccelerator(input)
+}
+
+func (c *awsClient) DeleteAccelerator(input *globalaccelerator.DeleteAcceleratorInput) (*globalaccelerator.DeleteAcceleratorOutput, error) {
+	return c.gaClient.DeleteAccelerator(input)
+}
+
+func (c *awsClient) ListListeners(input *globalaccelerator.ListListenersInput) (*globalaccelerator.ListListenersOutput, error) {
+	return c.gaClient.ListListeners(input)
+}
+
+func (c *awsClient) DeleteListener(input *globalaccelerator.DeleteListenerInput) (*globalaccelerator.DeleteListenerOutput, error) {
+	return c.gaClient.DeleteListener(input)
+}
+
+func (c *awsClient) ListEndpointGroups(input *globalaccelerator.ListEndpointGroupsInput) (*globalaccelerator.ListEndpointGroupsOutput, error) {
+	return c.gaClient.ListEndpointGroups(input)
+}
+
+func (c *awsClient) DeleteEndpointGroup(input *globalaccelerator.DeleteEndpointGroupInput) (*globalaccelerator.DeleteEndpointGroupOutput, error) {
+	return c.gaClient.DeleteEndpointGroup(input)
+}
+
 func (c *awsClient) GetRegion() string {
 	return c.region
 }
@@ -343,13 +1484,57 @@ func NewClient(awsAccessID, awsAccessSecret, token, region string) (Client, erro
 	}
 
 	return &awsClient{
-		region:        region,
-		ec2Client:     ec2.New(s),
-		stsClient:     sts.New(s),
-		s3Client:      s3.New(s),
-		route53client: route53.New(s),
-		elbClient:     elb.New(s),
-		elbv2Client:   elbv2.New(s),
-		efsClient:     efs.New(s),
+		region:           region,
+		ec2Client:        ec2.New(s),
+		stsClient:        sts.New(s),
+		s3Client:         s3.New(s),
+		route53client:    route53.New(s),
+		elbClient:        elb.New(s),
+		elbv2Client:      elbv2.New(s),
+		efsClient:        efs.New(s),
+		lambdaClient:     lambda.New(s),
+		eventsClient:     eventbridge.New(s),
+		sfnClient:        sfn.New(s),
+		ecrClient:        ecr.New(s),
+		ecsClient:        ecs.New(s),
+		eksClient:        eks.New(s),
+		logsClient:       cloudwatchlogs.New(s),
+		cloudwatchClient: cloudwatch.New(s),
+		kmsClient:        kms.New(s),
+		dynamodbClient:   dynamodb.New(s),
+		sqsClient:        sqs.New(s),
+		snsClient:        sns.New(s),
+		kinesisClient:    kinesis.New(s),
+		firehoseClient:   firehose.New(s),
+		secretsClient:    secretsmanager.New(s),
+		ssmClient:        ssm.New(s),
+		cacheClient:      elasticache.New(s),
+		esClient:         elasticsearchservice.New(s),
+		redshiftClient:   redshift.New(s),
+		s3controlClient:  s3control.New(s),
+		resolverClient:   route53resolver.New(s),
+		cloudfrontClient: cloudfront.New(s),
+		acmClient:        acm.New(s),
+		wafClient:        wafv2.New(s),
+		gaClient:         globalaccelerator.New(s, aws.NewConfig().WithRegion(globalAcceleratorRegion)),
 	}, nil
 }
+
+// GlobalRegion is the region label of clients for account-wide services such as Route53, S3 bucket listing and CloudFront
+const GlobalRegion = "global"
+
+// globalEndpointRegion is the region the endpoints of account-wide services are reached through
+const globalEndpointRegion = "us-east-1"
+
+// NewGlobalClient creates a client for account-wide services, its GetRegion returns GlobalRegion
+func NewGlobalClient(awsAccessID, awsAccessSecret, token string) (Client, error) {
+	client, err := NewClient(awsAccessID, awsAccessSecret, token, globalEndpointRegion)
+	if err != nil {
+		return nil, err
+	}
+	client.(*awsClient).region = GlobalRegion
+	return client, nil
+}
+
+// globalAcceleratorRegion is the only region the Global Accelerator API is served from
+const globalAcceleratorRegion = "us-west-2"
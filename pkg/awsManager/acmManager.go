@@ -0,0 +1,76 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListAcmCertificatesForDeletion returns the ARNs of the ACM certificates in the region
+func ListAcmCertificatesForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var certificatesToBeDeleted []*string
+	var token *string
+	for {
+		certificateList, err := client.ListCertificates(&acm.ListCertificatesInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list ACM certificates")
+			return nil, err
+		}
+
+		for _, certificate := range certificateList.CertificateSummaryList {
+			certificatesToBeDeleted = append(certificatesToBeDeleted, certificate.CertificateArn)
+		}
+
+		if certificateList.NextToken != nil {
+			token = certificateList.NextToken
+		} else {
+			break
+		}
+	}
+	return certificatesToBeDeleted, nil
+}
+
+// DeleteAcmCertificates deletes the given certificates
+// certificates still used by a load balancer or a CloudFront distribution fail until those are gone
+func DeleteAcmCertificates(client clientpkg.Client, certificatesToBeDeleted []*string, logger logr.Logger) error {
+
+	if certificatesToBeDeleted == nil {
+		return nil
+	}
+	var certificatesNotDeleted []*string
+	for _, certificateArn := range certificatesToBeDeleted {
+		_, err := client.DeleteCertificate(&acm.DeleteCertificateInput{CertificateArn: certificateArn})
+		if err != nil {
+			logger.Error(err, "Failed to delete ACM certificate", "ARN", *certificateArn)
+			certificatesNotDeleted = append(certificatesNotDeleted, certificateArn)
+			localMetrics.ResourceFail(localMetrics.AcmCertificate, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.AcmCertificate, client.GetRegion())
+	}
+
+	if certificatesNotDeleted != nil {
+		return errors.New("FailedComprehensiveAcmCertificateDeletion")
+	}
+	return nil
+}
+
+// CleanAcmCertificates lists and deletes ACM certificates
+// certificates are regional, so this runs in the regional pass after the load balancers are gone
+func CleanAcmCertificates(client clientpkg.Client, logger logr.Logger) error {
+	certificatesToBeDeleted, err := ListAcmCertificatesForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+	err = DeleteAcmCertificates(client, certificatesToBeDeleted, logger)
+	if err != nil {
+		logger.Error(err, "Failed to delete ACM certificates")
+		return err
+	}
+	logger.Info("All ACM certificates have been deleted for this region")
+	return nil
+}
@@ -2,21 +2,53 @@ package awsManager
 
 import (
 	"errors"
+	"reflect"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/go-logr/logr"
 
 	"github.com/golang/mock/gomock"
+	shredderConfig "github.com/openshift/aws-account-shredder/config"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
 	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
 	"github.com/openshift/aws-account-shredder/pkg/mock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -41,6 +73,20 @@ func init() {
 	localMetrics.Initialize("", "")
 }
 
+// expectS3BucketTeardown sets up the calls made while emptying buckets that hold nothing and have no configurations
+func expectS3BucketTeardown(r *mock.MockClientMockRecorder) {
+	r.GetCallerIdentity(gomock.Any()).Return(&sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil).AnyTimes()
+	r.DeleteBucketReplication(gomock.Any()).Return(&s3.DeleteBucketReplicationOutput{}, nil).AnyTimes()
+	r.DeleteBucketLifecycle(gomock.Any()).Return(&s3.DeleteBucketLifecycleOutput{}, nil).AnyTimes()
+	r.ListBucketInventoryConfigurations(gomock.Any()).Return(&s3.ListBucketInventoryConfigurationsOutput{}, nil).AnyTimes()
+	r.ListAccessPoints(gomock.Any()).Return(&s3control.ListAccessPointsOutput{}, nil).AnyTimes()
+	r.GetBucketPolicy(gomock.Any()).Return(nil, awserr.New("NoSuchBucketPolicy", "", nil)).AnyTimes()
+	r.GetBucketVersioning(gomock.Any()).Return(&s3.GetBucketVersioningOutput{}, nil).AnyTimes()
+	r.ListMultipartUploads(gomock.Any()).Return(&s3.ListMultipartUploadsOutput{}, nil).AnyTimes()
+	r.GetObjectLockConfiguration(gomock.Any()).Return(nil, awserr.New("ObjectLockConfigurationNotFoundError", "", nil)).AnyTimes()
+	r.ListObjectVersions(gomock.Any()).Return(&s3.ListObjectVersionsOutput{}, nil).AnyTimes()
+}
+
 func TestDeleteS3Buckets(t *testing.T) {
 	testCases := []struct {
 		title         string
@@ -58,7 +104,7 @@ func TestDeleteS3Buckets(t *testing.T) {
 		}, {
 			title: "test 2 - Invalid Buckets passed",
 			setupAWSMock: func(r *mock.MockClientMockRecorder) {
-				r.BatchDeleteBucketObjects(gomock.Any()).Return(nil).AnyTimes()
+				expectS3BucketTeardown(r)
 				r.DeleteBucket(gomock.Any()).Return(&s3.DeleteBucketOutput{}, errors.New("ERROR")).AnyTimes()
 				r.GetRegion().Return("Region1").AnyTimes()
 			},
@@ -67,7 +113,7 @@ func TestDeleteS3Buckets(t *testing.T) {
 		}, {
 			title: "test 3 - valid Buckets passed",
 			setupAWSMock: func(r *mock.MockClientMockRecorder) {
-				r.BatchDeleteBucketObjects(gomock.Any()).Return(nil).AnyTimes()
+				expectS3BucketTeardown(r)
 				r.DeleteBucket(gomock.Any()).Return(&s3.DeleteBucketOutput{}, nil).AnyTimes()
 				r.GetRegion().Return("Region1").AnyTimes()
 			},
@@ -117,6 +163,7 @@ func TestDeleteEc2Instance(t *testing.T) {
 			title: "test 2 - Invalid Instances passed",
 			setupAWSMock: func(r *mock.MockClientMockRecorder) {
 				r.TerminateInstances(gomock.Any()).Return(&ec2.TerminateInstancesOutput{}, errors.New("Error")).AnyTimes()
+				r.ModifyInstanceAttribute(gomock.Any()).Return(&ec2.ModifyInstanceAttributeOutput{}, nil).AnyTimes()
 				r.GetRegion().Return("Region1").AnyTimes()
 			},
 			listOfInstances: []*string{aws.String("abcd"), aws.String("abcd")},
@@ -125,6 +172,7 @@ func TestDeleteEc2Instance(t *testing.T) {
 			title: "test 3 - valid Instances passed",
 			setupAWSMock: func(r *mock.MockClientMockRecorder) {
 				r.TerminateInstances(gomock.Any()).Return(&ec2.TerminateInstancesOutput{}, nil).AnyTimes()
+				r.ModifyInstanceAttribute(gomock.Any()).Return(&ec2.ModifyInstanceAttributeOutput{}, nil).AnyTimes()
 				r.GetRegion().Return("Region1").AnyTimes()
 			},
 			listOfInstances: []*string{aws.String("abcd"), aws.String("abcd")},
@@ -133,10 +181,53 @@ func TestDeleteEc2Instance(t *testing.T) {
 			title: "test 4 - many Instances passed",
 			setupAWSMock: func(r *mock.MockClientMockRecorder) {
 				r.TerminateInstances(gomock.Any()).Return(&ec2.TerminateInstancesOutput{}, nil).AnyTimes()
+				r.ModifyInstanceAttribute(gomock.Any()).Return(&ec2.ModifyInstanceAttributeOutput{}, nil).AnyTimes()
 				r.GetRegion().Return("Region1").AnyTimes()
 			},
 			listOfInstances: createInstanceList(123),
 			errorExpected:   false,
+		}, {
+			title: "test 5 - protection is cleared before the batch, a failed batch is retried one by one",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				gomock.InOrder(
+					r.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{InstanceId: aws.String("i-00"), DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(false)}}).Return(&ec2.ModifyInstanceAttributeOutput{}, nil),
+					r.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{InstanceId: aws.String("i-00"), DisableApiStop: &ec2.AttributeBooleanValue{Value: aws.Bool(false)}}).Return(&ec2.ModifyInstanceAttributeOutput{}, nil),
+					r.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{InstanceId: aws.String("i-01"), DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(false)}}).Return(&ec2.ModifyInstanceAttributeOutput{}, nil),
+					r.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{InstanceId: aws.String("i-01"), DisableApiStop: &ec2.AttributeBooleanValue{Value: aws.Bool(false)}}).Return(&ec2.ModifyInstanceAttributeOutput{}, nil),
+					r.TerminateInstances(&ec2.TerminateInstancesInput{InstanceIds: []*string{aws.String("i-00"), aws.String("i-01")}}).Return(nil, errors.New("OperationNotPermitted")),
+					r.TerminateInstances(&ec2.TerminateInstancesInput{InstanceIds: []*string{aws.String("i-00")}}).Return(&ec2.TerminateInstancesOutput{}, nil),
+					r.TerminateInstances(&ec2.TerminateInstancesInput{InstanceIds: []*string{aws.String("i-01")}}).Return(&ec2.TerminateInstancesOutput{}, nil),
+				)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			listOfInstances: createInstanceList(2),
+			errorExpected:   false,
+		}, {
+			title: "test 6 - instance whose termination protection can not be cleared is still terminated on its own",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				gomock.InOrder(
+					r.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{InstanceId: aws.String("i-00"), DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(false)}}).Return(nil, errors.New("RequestLimitExceeded")),
+					r.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{InstanceId: aws.String("i-01"), DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(false)}}).Return(&ec2.ModifyInstanceAttributeOutput{}, nil),
+					r.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{InstanceId: aws.String("i-01"), DisableApiStop: &ec2.AttributeBooleanValue{Value: aws.Bool(false)}}).Return(&ec2.ModifyInstanceAttributeOutput{}, nil),
+					r.TerminateInstances(&ec2.TerminateInstancesInput{InstanceIds: []*string{aws.String("i-00")}}).Return(&ec2.TerminateInstancesOutput{}, nil),
+					r.TerminateInstances(&ec2.TerminateInstancesInput{InstanceIds: []*string{aws.String("i-01")}}).Return(&ec2.TerminateInstancesOutput{}, nil),
+				)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			listOfInstances: createInstanceList(2),
+			errorExpected:   false,
+		}, {
+			title: "test 7 - instance whose stop protection can not be cleared is still terminated",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				gomock.InOrder(
+					r.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{InstanceId: aws.String("i-00"), DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(false)}}).Return(&ec2.ModifyInstanceAttributeOutput{}, nil),
+					r.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{InstanceId: aws.String("i-00"), DisableApiStop: &ec2.AttributeBooleanValue{Value: aws.Bool(false)}}).Return(nil, errors.New("UnsupportedOperation")),
+					r.TerminateInstances(&ec2.TerminateInstancesInput{InstanceIds: []*string{aws.String("i-00")}}).Return(&ec2.TerminateInstancesOutput{}, nil),
+				)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			listOfInstances: createInstanceList(1),
+			errorExpected:   false,
 		},
 	}
 
@@ -154,6 +245,100 @@ func TestDeleteEc2Instance(t *testing.T) {
 	}
 }
 
+func TestListEc2InstancesForDeletion(t *testing.T) {
+	instance := func(id string, stateCode int64, tags ...string) *ec2.Instance {
+		i := &ec2.Instance{InstanceId: aws.String(id), State: &ec2.InstanceState{Code: aws.Int64(stateCode)}}
+		for _, tag := range tags {
+			keyValue := strings.SplitN(tag, "=", 2)
+			i.Tags = append(i.Tags, &ec2.Tag{Key: aws.String(keyValue[0]), Value: aws.String(keyValue[1])})
+		}
+		return i
+	}
+	instances := []*ec2.Instance{
+		instance("i-cluster", 16, "kubernetes.io/cluster/abc=owned"),
+		instance("i-claim", 16, "clusterClaimLink=claim"),
+		instance("i-untagged", 16),
+		instance("i-sandbox", 80, "sandbox-owner=dev"),
+		instance("i-kept", 16, "kubernetes.io/cluster/abc=owned", "keep=true"),
+		instance("i-terminated", 48, "kubernetes.io/cluster/abc=owned"),
+	}
+
+	testCases := []struct {
+		title     string
+		selection shredderConfig.Ec2Selection
+		expected  []string
+	}{
+		{
+			title:     "cluster tags",
+			selection: shredderConfig.Ec2Selection{Mode: shredderConfig.Ec2SelectionModeClusterTags},
+			expected:  []string{"i-cluster", "i-claim", "i-kept"},
+		}, {
+			title:     "all instances",
+			selection: shredderConfig.Ec2Selection{Mode: shredderConfig.Ec2SelectionModeAll},
+			expected:  []string{"i-cluster", "i-claim", "i-untagged", "i-sandbox", "i-kept"},
+		}, {
+			title:     "tag key prefixes",
+			selection: shredderConfig.Ec2Selection{Mode: shredderConfig.Ec2SelectionModeTagKey, TagKeys: []string{"sandbox-", "clusterClaim"}},
+			expected:  []string{"i-claim", "i-sandbox"},
+		}, {
+			title:     "tag value regex",
+			selection: shredderConfig.Ec2Selection{Mode: shredderConfig.Ec2SelectionModeTagValue, TagValueRegex: regexp.MustCompile("^(dev|claim)$")},
+			expected:  []string{"i-claim", "i-sandbox"},
+		}, {
+			title:     "excluded tags",
+			selection: shredderConfig.Ec2Selection{Mode: shredderConfig.Ec2SelectionModeAll, ExcludeTags: map[string]string{"keep": "", "sandbox-owner": "dev", "clusterClaimLink": "other"}},
+			expected:  []string{"i-cluster", "i-claim", "i-untagged"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			mocks.mockAWSClient.EXPECT().DescribeInstances(gomock.Any()).Return(&ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{{Instances: instances}},
+			}, nil)
+
+			instanceIDs, err := ListEc2InstancesForDeletion(mocks.mockAWSClient, tc.selection, mocks.Logger)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if selected := aws.StringValueSlice(instanceIDs); !reflect.DeepEqual(selected, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, selected)
+			}
+		})
+	}
+
+	t.Run("describe failure is returned", func(t *testing.T) {
+		mocks := setupDefaultMocks(t)
+		mocks.mockAWSClient.EXPECT().DescribeInstances(gomock.Any()).Return(nil, errors.New("RequestLimitExceeded"))
+
+		if _, err := ListEc2InstancesForDeletion(mocks.mockAWSClient, shredderConfig.Ec2Selection{}, mocks.Logger); err == nil {
+			t.Error("expected the DescribeInstances error to be returned")
+		}
+	})
+}
+
+func TestCancelSpotInstanceRequests(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+
+	gomock.InOrder(
+		r.DescribeSpotInstanceRequests(gomock.Any()).Return(&ec2.DescribeSpotInstanceRequestsOutput{
+			SpotInstanceRequests: []*ec2.SpotInstanceRequest{
+				{SpotInstanceRequestId: aws.String("sir-open"), State: aws.String(ec2.SpotInstanceStateOpen)},
+				{SpotInstanceRequestId: aws.String("sir-cancelled"), State: aws.String(ec2.SpotInstanceStateCancelled)},
+				{SpotInstanceRequestId: aws.String("sir-active"), State: aws.String(ec2.SpotInstanceStateActive)},
+			},
+		}, nil),
+		r.CancelSpotInstanceRequests(&ec2.CancelSpotInstanceRequestsInput{SpotInstanceRequestIds: []*string{aws.String("sir-open"), aws.String("sir-active")}}).Return(&ec2.CancelSpotInstanceRequestsOutput{}, nil),
+	)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := CancelSpotInstanceRequests(mocks.mockAWSClient, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
 func TestCleanUpAwsRoute53(t *testing.T) {
 	testCases := []struct {
 		title         string
@@ -163,6 +348,7 @@ func TestCleanUpAwsRoute53(t *testing.T) {
 		{
 			title: "test 1 - unable to list hosted zone in that region",
 			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.ListTrafficPolicyInstances(gomock.Any()).Return(&route53.ListTrafficPolicyInstancesOutput{}, nil).AnyTimes()
 				r.ListHostedZones(gomock.Any()).Return(&route53.ListHostedZonesOutput{}, errors.New("unable to lst hosted zones")).AnyTimes()
 			},
 			errorExpected: true,
@@ -173,6 +359,8 @@ func TestCleanUpAwsRoute53(t *testing.T) {
 				r.ListResourceRecordSets(gomock.Any()).Return(&route53.ListResourceRecordSetsOutput{}, nil).AnyTimes()
 				r.ChangeResourceRecordSets(gomock.Any()).Return(&route53.ChangeResourceRecordSetsOutput{}, nil).AnyTimes()
 				r.DeleteHostedZone(gomock.Any()).Return(&route53.DeleteHostedZoneOutput{}, nil).AnyTimes()
+				r.ListTrafficPolicyInstances(gomock.Any()).Return(&route53.ListTrafficPolicyInstancesOutput{}, nil).AnyTimes()
+				r.ListHealthChecks(gomock.Any()).Return(&route53.ListHealthChecksOutput{}, nil).AnyTimes()
 			},
 			errorExpected: true,
 		},
@@ -205,6 +393,7 @@ func TestDeleteVpcInstacnes(t *testing.T) {
 		{
 			title: "test 1 - All the resource clear up in the VPC",
 			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeFlowLogs(gomock.Any()).Return(&ec2.DescribeFlowLogsOutput{}, nil).AnyTimes()
 				r.DescribeVpcEndpoints(gomock.Any()).Return(&ec2.DescribeVpcEndpointsOutput{}, nil).AnyTimes()
 				r.DeleteVpcEndpoints(gomock.Any()).Return(&ec2.DeleteVpcEndpointsOutput{}, nil).AnyTimes()
 				r.DescribeLoadBalancers(gomock.Any()).Return(&elb.DescribeLoadBalancersOutput{}, nil).AnyTimes()
@@ -218,6 +407,8 @@ func TestDeleteVpcInstacnes(t *testing.T) {
 				r.DeleteNetworkInterface(gomock.Any()).Return(&ec2.DeleteNetworkInterfaceOutput{}, nil).AnyTimes()
 				r.DescribeInternetGateways(gomock.Any()).Return(&ec2.DescribeInternetGatewaysOutput{}, nil).AnyTimes()
 				r.DeleteInternetGateway(gomock.Any()).Return(&ec2.DeleteInternetGatewayOutput{}, nil).AnyTimes()
+				r.DescribeEgressOnlyInternetGateways(gomock.Any()).Return(&ec2.DescribeEgressOnlyInternetGatewaysOutput{}, nil).AnyTimes()
+				r.DescribeCarrierGateways(gomock.Any()).Return(&ec2.DescribeCarrierGatewaysOutput{}, nil).AnyTimes()
 				r.DescribeVpnGateways(gomock.Any()).Return(&ec2.DescribeVpnGatewaysOutput{}, nil).AnyTimes()
 				r.DescribeNetworkAcls(gomock.Any()).Return(&ec2.DescribeNetworkAclsOutput{}, nil).AnyTimes()
 				r.DeleteNetworkAcl(gomock.Any()).Return(&ec2.DeleteNetworkAclOutput{}, nil).AnyTimes()
@@ -226,6 +417,7 @@ func TestDeleteVpcInstacnes(t *testing.T) {
 				r.DeleteRouteTable(gomock.Any()).Return(&ec2.DeleteRouteTableOutput{}, nil).AnyTimes()
 				r.DescribeSubnets(gomock.Any()).Return(&ec2.DescribeSubnetsOutput{}, nil).AnyTimes()
 				r.DeleteSubnet(gomock.Any()).Return(&ec2.DeleteSubnetOutput{}, nil).AnyTimes()
+				r.DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{}, nil).AnyTimes()
 				r.DescribeSecurityGroups(gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{}, nil).AnyTimes()
 				r.RevokeSecurityGroupIngress(gomock.Any()).Return(&ec2.RevokeSecurityGroupIngressOutput{}, nil).AnyTimes()
 				r.DeleteSecurityGroup(gomock.Any()).Return(&ec2.DeleteSecurityGroupOutput{}, nil).AnyTimes()
@@ -236,6 +428,7 @@ func TestDeleteVpcInstacnes(t *testing.T) {
 		}, {
 			title: "test 2 - All the resources dont clear up in the VPC",
 			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeFlowLogs(gomock.Any()).Return(&ec2.DescribeFlowLogsOutput{}, nil).AnyTimes()
 				r.DescribeVpcEndpoints(gomock.Any()).Return(&ec2.DescribeVpcEndpointsOutput{}, nil).AnyTimes()
 				r.DeleteVpcEndpoints(gomock.Any()).Return(&ec2.DeleteVpcEndpointsOutput{}, nil).AnyTimes()
 				r.DescribeLoadBalancers(gomock.Any()).Return(&elb.DescribeLoadBalancersOutput{}, nil).AnyTimes()
@@ -249,6 +442,8 @@ func TestDeleteVpcInstacnes(t *testing.T) {
 				r.DeleteNetworkInterface(gomock.Any()).Return(&ec2.DeleteNetworkInterfaceOutput{}, nil).AnyTimes()
 				r.DescribeInternetGateways(gomock.Any()).Return(&ec2.DescribeInternetGatewaysOutput{}, nil).AnyTimes()
 				r.DeleteInternetGateway(gomock.Any()).Return(&ec2.DeleteInternetGatewayOutput{}, nil).AnyTimes()
+				r.DescribeEgressOnlyInternetGateways(gomock.Any()).Return(&ec2.DescribeEgressOnlyInternetGatewaysOutput{}, nil).AnyTimes()
+				r.DescribeCarrierGateways(gomock.Any()).Return(&ec2.DescribeCarrierGatewaysOutput{}, nil).AnyTimes()
 				r.DescribeVpnGateways(gomock.Any()).Return(&ec2.DescribeVpnGatewaysOutput{}, nil).AnyTimes()
 				r.DescribeNetworkAcls(gomock.Any()).Return(&ec2.DescribeNetworkAclsOutput{}, nil).AnyTimes()
 				r.DeleteNetworkAcl(gomock.Any()).Return(&ec2.DeleteNetworkAclOutput{}, nil).AnyTimes()
@@ -257,6 +452,7 @@ func TestDeleteVpcInstacnes(t *testing.T) {
 				r.DeleteRouteTable(gomock.Any()).Return(&ec2.DeleteRouteTableOutput{}, nil).AnyTimes()
 				r.DescribeSubnets(gomock.Any()).Return(&ec2.DescribeSubnetsOutput{}, nil).AnyTimes()
 				r.DeleteSubnet(gomock.Any()).Return(&ec2.DeleteSubnetOutput{}, nil).AnyTimes()
+				r.DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{}, nil).AnyTimes()
 				r.DescribeSecurityGroups(gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{}, nil).AnyTimes()
 				r.RevokeSecurityGroupIngress(gomock.Any()).Return(&ec2.RevokeSecurityGroupIngressOutput{}, nil).AnyTimes()
 				r.DeleteSecurityGroup(gomock.Any()).Return(&ec2.DeleteSecurityGroupOutput{}, nil).AnyTimes()
@@ -275,7 +471,7 @@ func TestDeleteVpcInstacnes(t *testing.T) {
 			mocks := setupDefaultMocks(t)
 			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
 
-			mockExecution := DeleteVpcInstances(mocks.mockAWSClient, tc.listOfInstances, mocks.Logger)
+			mockExecution := DeleteVpcInstances(mocks.mockAWSClient, tc.listOfInstances, 0, mocks.Logger)
 
 			if mockExecution != nil && tc.errorExpected == false {
 				t.Errorf(tc.title, "Failed")
@@ -540,3 +736,2083 @@ func TestCleanEIPAddresses(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteLambdaFunctions(t *testing.T) {
+	testCases := []struct {
+		title                string
+		setupAWSMock         func(r *mock.MockClientMockRecorder)
+		functionsToBeDeleted []*string
+		errorExpected        bool
+	}{
+		{
+			title: "test 1 - No Lambda functions passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			functionsToBeDeleted: nil,
+			errorExpected:        false,
+		}, {
+			title: "test 2 - Invalid Lambda functions passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteFunction(gomock.Any()).Return(&lambda.DeleteFunctionOutput{}, errors.New("ERROR")).AnyTimes()
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			functionsToBeDeleted: []*string{aws.String("abcd"), aws.String("abcd")},
+			errorExpected:        true,
+		}, {
+			title: "test 3 - valid Lambda functions passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteFunction(gomock.Any()).Return(&lambda.DeleteFunctionOutput{}, nil).Times(2)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			functionsToBeDeleted: []*string{aws.String("abcd"), aws.String("abcd")},
+			errorExpected:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteLambdaFunctions(mocks.mockAWSClient, tc.functionsToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestDeleteEventBuses(t *testing.T) {
+	testCases := []struct {
+		title                 string
+		setupAWSMock          func(r *mock.MockClientMockRecorder)
+		eventBusesToBeDeleted []*string
+		errorExpected         bool
+	}{
+		{
+			title: "test 1 - default event bus is emptied but not deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.ListRules(gomock.Any()).Return(&eventbridge.ListRulesOutput{Rules: []*eventbridge.Rule{
+					{Name: aws.String("rule"), ManagedBy: aws.String("events.amazonaws.com")},
+				}}, nil).Times(1)
+				r.ListTargetsByRule(gomock.Any()).Return(&eventbridge.ListTargetsByRuleOutput{Targets: []*eventbridge.Target{
+					{Id: aws.String("target")},
+				}}, nil).Times(1)
+				r.RemoveTargets(gomock.Any()).Return(&eventbridge.RemoveTargetsOutput{}, nil).Times(1)
+				r.DeleteRule(&eventbridge.DeleteRuleInput{EventBusName: aws.String("default"), Name: aws.String("rule"), Force: aws.Bool(true)}).Return(&eventbridge.DeleteRuleOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			eventBusesToBeDeleted: []*string{aws.String("default")},
+			errorExpected:         false,
+		}, {
+			title: "test 2 - custom event bus is deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.ListRules(gomock.Any()).Return(&eventbridge.ListRulesOutput{}, nil).Times(1)
+				r.DeleteEventBus(gomock.Any()).Return(&eventbridge.DeleteEventBusOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			eventBusesToBeDeleted: []*string{aws.String("custom")},
+			errorExpected:         false,
+		}, {
+			title: "test 3 - rule can not be deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.ListRules(gomock.Any()).Return(&eventbridge.ListRulesOutput{Rules: []*eventbridge.Rule{
+					{Name: aws.String("rule")},
+				}}, nil).Times(1)
+				r.ListTargetsByRule(gomock.Any()).Return(&eventbridge.ListTargetsByRuleOutput{}, nil).Times(1)
+				r.DeleteRule(gomock.Any()).Return(&eventbridge.DeleteRuleOutput{}, errors.New("ERROR")).Times(1)
+				r.DeleteEventBus(gomock.Any()).Return(&eventbridge.DeleteEventBusOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			eventBusesToBeDeleted: []*string{aws.String("custom")},
+			errorExpected:         true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteEventBuses(mocks.mockAWSClient, tc.eventBusesToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestDeleteStateMachines(t *testing.T) {
+	testCases := []struct {
+		title                    string
+		setupAWSMock             func(r *mock.MockClientMockRecorder)
+		stateMachinesToBeDeleted []*string
+		errorExpected            bool
+	}{
+		{
+			title: "test 1 - No state machines passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			stateMachinesToBeDeleted: nil,
+			errorExpected:            false,
+		}, {
+			title: "test 2 - Invalid state machines passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteStateMachine(gomock.Any()).Return(&sfn.DeleteStateMachineOutput{}, errors.New("ERROR")).AnyTimes()
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			stateMachinesToBeDeleted: []*string{aws.String("abcd"), aws.String("abcd")},
+			errorExpected:            true,
+		}, {
+			title: "test 3 - valid state machines passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteStateMachine(gomock.Any()).Return(&sfn.DeleteStateMachineOutput{}, nil).Times(2)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			stateMachinesToBeDeleted: []*string{aws.String("abcd"), aws.String("abcd")},
+			errorExpected:            false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteStateMachines(mocks.mockAWSClient, tc.stateMachinesToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestDeleteEcrRepositories(t *testing.T) {
+	testCases := []struct {
+		title                   string
+		setupAWSMock            func(r *mock.MockClientMockRecorder)
+		repositoriesToBeDeleted []*string
+		errorExpected           bool
+	}{
+		{
+			title: "test 1 - No repositories passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			repositoriesToBeDeleted: nil,
+			errorExpected:           false,
+		}, {
+			title: "test 2 - Invalid repositories passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteRepository(gomock.Any()).Return(&ecr.DeleteRepositoryOutput{}, errors.New("ERROR")).AnyTimes()
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			repositoriesToBeDeleted: []*string{aws.String("abcd"), aws.String("abcd")},
+			errorExpected:           true,
+		}, {
+			title: "test 3 - valid repositories are force deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteRepository(&ecr.DeleteRepositoryInput{RepositoryName: aws.String("abcd"), Force: aws.Bool(true)}).Return(&ecr.DeleteRepositoryOutput{}, nil).Times(2)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			repositoriesToBeDeleted: []*string{aws.String("abcd"), aws.String("abcd")},
+			errorExpected:           false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteEcrRepositories(mocks.mockAWSClient, tc.repositoriesToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestDeleteEksClusters(t *testing.T) {
+	testCases := []struct {
+		title               string
+		setupAWSMock        func(r *mock.MockClientMockRecorder)
+		clustersToBeDeleted []*string
+		errorExpected       bool
+	}{
+		{
+			title: "test 1 - cluster without nodegroups or Fargate profiles is deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.ListNodegroups(gomock.Any()).Return(&eks.ListNodegroupsOutput{}, nil).Times(1)
+				r.ListFargateProfiles(gomock.Any()).Return(&eks.ListFargateProfilesOutput{}, nil).Times(1)
+				r.DeleteClusterEKS(gomock.Any()).Return(&eks.DeleteClusterOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			clustersToBeDeleted: []*string{aws.String("abcd")},
+			errorExpected:       false,
+		}, {
+			title: "test 2 - cluster deletion deferred while nodegroups are deleting",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.ListNodegroups(gomock.Any()).Return(&eks.ListNodegroupsOutput{Nodegroups: []*string{aws.String("ng")}}, nil).Times(1)
+				r.DeleteNodegroup(gomock.Any()).Return(&eks.DeleteNodegroupOutput{}, nil).Times(1)
+				r.ListFargateProfiles(gomock.Any()).Return(&eks.ListFargateProfilesOutput{FargateProfileNames: []*string{aws.String("fp")}}, nil).Times(1)
+				r.DeleteFargateProfile(gomock.Any()).Return(&eks.DeleteFargateProfileOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			clustersToBeDeleted: []*string{aws.String("abcd")},
+			errorExpected:       true,
+		}, {
+			title: "test 3 - unable to list nodegroups",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.ListNodegroups(gomock.Any()).Return(&eks.ListNodegroupsOutput{}, errors.New("ERROR")).Times(1)
+			},
+			clustersToBeDeleted: []*string{aws.String("abcd")},
+			errorExpected:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteEksClusters(mocks.mockAWSClient, tc.clustersToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestDeleteEcsServices(t *testing.T) {
+	testCases := []struct {
+		title         string
+		setupAWSMock  func(r *mock.MockClientMockRecorder)
+		errorExpected bool
+	}{
+		{
+			title: "test 1 - service is scaled to zero and deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.ListServices(gomock.Any()).Return(&ecs.ListServicesOutput{ServiceArns: []*string{aws.String("service")}}, nil).Times(1)
+				r.UpdateService(gomock.Any()).Return(&ecs.UpdateServiceOutput{}, nil).Times(1)
+				r.DeleteService(&ecs.DeleteServiceInput{Cluster: aws.String("cluster"), Service: aws.String("service"), Force: aws.Bool(false)}).Return(&ecs.DeleteServiceOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			errorExpected: false,
+		}, {
+			title: "test 2 - daemon service that can not be scaled is force deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.ListServices(gomock.Any()).Return(&ecs.ListServicesOutput{ServiceArns: []*string{aws.String("service")}}, nil).Times(1)
+				r.UpdateService(gomock.Any()).Return(&ecs.UpdateServiceOutput{}, errors.New("ERROR")).Times(1)
+				r.DeleteService(&ecs.DeleteServiceInput{Cluster: aws.String("cluster"), Service: aws.String("service"), Force: aws.Bool(true)}).Return(&ecs.DeleteServiceOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			errorExpected: false,
+		}, {
+			title: "test 3 - service can not be deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.ListServices(gomock.Any()).Return(&ecs.ListServicesOutput{ServiceArns: []*string{aws.String("service")}}, nil).Times(1)
+				r.UpdateService(gomock.Any()).Return(&ecs.UpdateServiceOutput{}, nil).Times(1)
+				r.DeleteService(gomock.Any()).Return(&ecs.DeleteServiceOutput{}, errors.New("ERROR")).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteEcsServices(mocks.mockAWSClient, aws.String("cluster"), mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestListLogGroupsForDeletion(t *testing.T) {
+	testCases := []struct {
+		title            string
+		setupAWSMock     func(r *mock.MockClientMockRecorder)
+		excludedPrefixes []string
+		expected         []string
+		errorExpected    bool
+	}{
+		{
+			title: "test 1 - all log groups listed when nothing is excluded",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeLogGroups(gomock.Any()).Return(&cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: []*cloudwatchlogs.LogGroup{
+					{LogGroupName: aws.String("/aws/lambda/function")},
+					{LogGroupName: aws.String("/org/audit/trail")},
+				}}, nil).Times(1)
+			},
+			excludedPrefixes: nil,
+			expected:         []string{"/aws/lambda/function", "/org/audit/trail"},
+			errorExpected:    false,
+		}, {
+			title: "test 2 - excluded prefixes are skipped across pages",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				gomock.InOrder(
+					r.DescribeLogGroups(gomock.Any()).Return(&cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: []*cloudwatchlogs.LogGroup{
+						{LogGroupName: aws.String("/aws/lambda/function")},
+						{LogGroupName: aws.String("/org/audit/trail")},
+					}, NextToken: aws.String("token")}, nil),
+					r.DescribeLogGroups(&cloudwatchlogs.DescribeLogGroupsInput{NextToken: aws.String("token")}).Return(&cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: []*cloudwatchlogs.LogGroup{
+						{LogGroupName: aws.String("/aws/eks/cluster")},
+					}}, nil),
+				)
+			},
+			excludedPrefixes: []string{"/org/audit/"},
+			expected:         []string{"/aws/lambda/function", "/aws/eks/cluster"},
+			errorExpected:    false,
+		}, {
+			title: "test 3 - unable to list log groups",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeLogGroups(gomock.Any()).Return(&cloudwatchlogs.DescribeLogGroupsOutput{}, errors.New("ERROR")).Times(1)
+			},
+			excludedPrefixes: nil,
+			expected:         []string{},
+			errorExpected:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			logGroups, err := ListLogGroupsForDeletion(mocks.mockAWSClient, tc.excludedPrefixes, mocks.Logger)
+
+			if (err != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+			if !reflect.DeepEqual(aws.StringValueSlice(logGroups), tc.expected) {
+				t.Errorf("%s: expected %v, got %v", tc.title, tc.expected, aws.StringValueSlice(logGroups))
+			}
+		})
+	}
+}
+
+func TestDeleteAlarms(t *testing.T) {
+	testCases := []struct {
+		title             string
+		setupAWSMock      func(r *mock.MockClientMockRecorder)
+		alarmsToBeDeleted []*string
+		errorExpected     bool
+	}{
+		{
+			title: "test 1 - No alarms passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			alarmsToBeDeleted: nil,
+			errorExpected:     false,
+		}, {
+			title: "test 2 - alarms are deleted in batches",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteAlarms(gomock.Any()).Return(&cloudwatch.DeleteAlarmsOutput{}, nil).Times(2)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			alarmsToBeDeleted: createInstanceList(123),
+			errorExpected:     false,
+		}, {
+			title: "test 3 - Invalid alarms passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteAlarms(gomock.Any()).Return(&cloudwatch.DeleteAlarmsOutput{}, errors.New("ERROR")).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			alarmsToBeDeleted: []*string{aws.String("abcd"), aws.String("abcd")},
+			errorExpected:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteAlarms(mocks.mockAWSClient, tc.alarmsToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestDeleteMetricStreams(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+
+	gomock.InOrder(
+		r.ListMetricStreams(&cloudwatch.ListMetricStreamsInput{}).Return(&cloudwatch.ListMetricStreamsOutput{
+			Entries:   []*cloudwatch.MetricStreamEntry{{Name: aws.String("stream-1")}},
+			NextToken: aws.String("page-2"),
+		}, nil),
+		r.DeleteMetricStream(&cloudwatch.DeleteMetricStreamInput{Name: aws.String("stream-1")}).Return(&cloudwatch.DeleteMetricStreamOutput{}, nil),
+		r.ListMetricStreams(&cloudwatch.ListMetricStreamsInput{NextToken: aws.String("page-2")}).Return(&cloudwatch.ListMetricStreamsOutput{
+			Entries: []*cloudwatch.MetricStreamEntry{{Name: aws.String("stream-2")}},
+		}, nil),
+		r.DeleteMetricStream(&cloudwatch.DeleteMetricStreamInput{Name: aws.String("stream-2")}).Return(nil, errors.New("ERROR")),
+	)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := DeleteMetricStreams(mocks.mockAWSClient, mocks.Logger); err == nil {
+		t.Error("expected an error for the metric stream that could not be deleted")
+	}
+}
+
+func TestListKmsKeysForDeletion(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	r.ListKeys(gomock.Any()).Return(&kms.ListKeysOutput{Keys: []*kms.KeyListEntry{
+		{KeyId: aws.String("aws-managed")},
+		{KeyId: aws.String("pending-deletion")},
+		{KeyId: aws.String("customer")},
+	}, Truncated: aws.Bool(false)}, nil).Times(1)
+	r.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String("aws-managed")}).Return(&kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{
+		KeyManager: aws.String(kms.KeyManagerTypeAws), KeyState: aws.String(kms.KeyStateEnabled),
+	}}, nil).Times(1)
+	r.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String("pending-deletion")}).Return(&kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{
+		KeyManager: aws.String(kms.KeyManagerTypeCustomer), KeyState: aws.String(kms.KeyStatePendingDeletion),
+	}}, nil).Times(1)
+	r.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String("customer")}).Return(&kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{
+		KeyManager: aws.String(kms.KeyManagerTypeCustomer), KeyState: aws.String(kms.KeyStateEnabled),
+	}}, nil).Times(1)
+
+	keys, err := ListKmsKeysForDeletion(mocks.mockAWSClient, mocks.Logger)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(aws.StringValueSlice(keys), []string{"customer"}) {
+		t.Errorf("expected only the customer managed key, got %v", aws.StringValueSlice(keys))
+	}
+}
+
+func TestScheduleKmsKeyDeletion(t *testing.T) {
+	testCases := []struct {
+		title           string
+		setupAWSMock    func(r *mock.MockClientMockRecorder)
+		keysToBeDeleted []*string
+		errorExpected   bool
+	}{
+		{
+			title: "test 1 - No keys passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			keysToBeDeleted: nil,
+			errorExpected:   false,
+		}, {
+			title: "test 2 - keys are disabled and scheduled with the pending window",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DisableKey(gomock.Any()).Return(&kms.DisableKeyOutput{}, nil).Times(1)
+				r.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{KeyId: aws.String("abcd"), PendingWindowInDays: aws.Int64(15)}).Return(&kms.ScheduleKeyDeletionOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			keysToBeDeleted: []*string{aws.String("abcd")},
+			errorExpected:   false,
+		}, {
+			title: "test 3 - key already pending deletion is a success",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DisableKey(gomock.Any()).Return(&kms.DisableKeyOutput{}, errors.New("ERROR")).Times(1)
+				r.ScheduleKeyDeletion(gomock.Any()).Return(nil, awserr.New(kms.ErrCodeInvalidStateException, "arn:aws:kms:us-east-1:123456789012:key/abcd is pending deletion.", nil)).Times(1)
+				r.DescribeKey(gomock.Any()).Return(&kms.DescribeKeyOutput{KeyMetadata: &kms.KeyMetadata{KeyState: aws.String(kms.KeyStatePendingDeletion)}}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			keysToBeDeleted: []*string{aws.String("abcd")},
+			errorExpected:   false,
+		}, {
+			title: "test 4 - key can not be scheduled",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DisableKey(gomock.Any()).Return(&kms.DisableKeyOutput{}, nil).Times(1)
+				r.ScheduleKeyDeletion(gomock.Any()).Return(nil, errors.New("ERROR")).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			keysToBeDeleted: []*string{aws.String("abcd")},
+			errorExpected:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := ScheduleKmsKeyDeletion(mocks.mockAWSClient, tc.keysToBeDeleted, 15, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestDeleteDynamoDBTables(t *testing.T) {
+	testCases := []struct {
+		title             string
+		setupAWSMock      func(r *mock.MockClientMockRecorder)
+		tablesToBeDeleted []*string
+		errorExpected     bool
+	}{
+		{
+			title: "test 1 - No tables passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			tablesToBeDeleted: nil,
+			errorExpected:     false,
+		}, {
+			title: "test 2 - tables deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeTable(gomock.Any()).Return(&dynamodb.DescribeTableOutput{Table: &dynamodb.TableDescription{}}, nil).Times(2)
+				r.DeleteTable(gomock.Any()).Return(&dynamodb.DeleteTableOutput{}, nil).Times(2)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			tablesToBeDeleted: []*string{aws.String("table1"), aws.String("table2")},
+			errorExpected:     false,
+		}, {
+			title: "test 3 - one table fails to delete",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeTable(gomock.Any()).Return(&dynamodb.DescribeTableOutput{Table: &dynamodb.TableDescription{}}, nil).Times(2)
+				r.DeleteTable(&dynamodb.DeleteTableInput{TableName: aws.String("table1")}).Return(nil, errors.New("ERROR")).Times(1)
+				r.DeleteTable(&dynamodb.DeleteTableInput{TableName: aws.String("table2")}).Return(&dynamodb.DeleteTableOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			tablesToBeDeleted: []*string{aws.String("table1"), aws.String("table2")},
+			errorExpected:     true,
+		}, {
+			title: "test 4 - deletion protection is turned off first",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				gomock.InOrder(
+					r.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("table1")}).Return(&dynamodb.DescribeTableOutput{Table: &dynamodb.TableDescription{DeletionProtectionEnabled: aws.Bool(true)}}, nil),
+					r.UpdateTable(&dynamodb.UpdateTableInput{TableName: aws.String("table1"), DeletionProtectionEnabled: aws.Bool(false)}).Return(&dynamodb.UpdateTableOutput{}, nil),
+					r.DeleteTable(&dynamodb.DeleteTableInput{TableName: aws.String("table1")}).Return(&dynamodb.DeleteTableOutput{}, nil),
+				)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			tablesToBeDeleted: []*string{aws.String("table1")},
+			errorExpected:     false,
+		}, {
+			title: "test 5 - table stays protected",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeTable(gomock.Any()).Return(&dynamodb.DescribeTableOutput{Table: &dynamodb.TableDescription{DeletionProtectionEnabled: aws.Bool(true)}}, nil)
+				r.UpdateTable(gomock.Any()).Return(nil, errors.New("ERROR"))
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			tablesToBeDeleted: []*string{aws.String("table1")},
+			errorExpected:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteDynamoDBTables(mocks.mockAWSClient, tc.tablesToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestListSnsSubscriptionsForDeletion(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	r.ListSubscriptions(&sns.ListSubscriptionsInput{}).Return(&sns.ListSubscriptionsOutput{Subscriptions: []*sns.Subscription{
+		{SubscriptionArn: aws.String("arn:aws:sns:us-east-1:123456789012:topic:abcd")},
+		{SubscriptionArn: aws.String("PendingConfirmation")},
+	}, NextToken: aws.String("token")}, nil).Times(1)
+	r.ListSubscriptions(&sns.ListSubscriptionsInput{NextToken: aws.String("token")}).Return(&sns.ListSubscriptionsOutput{Subscriptions: []*sns.Subscription{
+		{SubscriptionArn: aws.String("arn:aws:sns:us-east-1:123456789012:topic:efgh")},
+	}}, nil).Times(1)
+
+	subscriptions, err := ListSnsSubscriptionsForDeletion(mocks.mockAWSClient, mocks.Logger)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	expected := []string{"arn:aws:sns:us-east-1:123456789012:topic:abcd", "arn:aws:sns:us-east-1:123456789012:topic:efgh"}
+	if !reflect.DeepEqual(aws.StringValueSlice(subscriptions), expected) {
+		t.Errorf("expected %v, got %v", expected, aws.StringValueSlice(subscriptions))
+	}
+}
+
+func TestDeleteKinesisStreams(t *testing.T) {
+	testCases := []struct {
+		title              string
+		setupAWSMock       func(r *mock.MockClientMockRecorder)
+		streamsToBeDeleted []*string
+		errorExpected      bool
+	}{
+		{
+			title: "test 1 - No streams passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			streamsToBeDeleted: nil,
+			errorExpected:      false,
+		}, {
+			title: "test 2 - consumers are deleted with the stream",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteStream(&kinesis.DeleteStreamInput{StreamName: aws.String("stream1"), EnforceConsumerDeletion: aws.Bool(true)}).Return(&kinesis.DeleteStreamOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			streamsToBeDeleted: []*string{aws.String("stream1")},
+			errorExpected:      false,
+		}, {
+			title: "test 3 - stream fails to delete",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteStream(gomock.Any()).Return(nil, errors.New("ERROR")).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			streamsToBeDeleted: []*string{aws.String("stream1")},
+			errorExpected:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteKinesisStreams(mocks.mockAWSClient, tc.streamsToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestListFirehoseStreamsForDeletion(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+
+	gomock.InOrder(
+		r.ListDeliveryStreams(&firehose.ListDeliveryStreamsInput{}).Return(&firehose.ListDeliveryStreamsOutput{
+			DeliveryStreamNames:    []*string{aws.String("stream1"), aws.String("stream2")},
+			HasMoreDeliveryStreams: aws.Bool(true),
+		}, nil),
+		r.ListDeliveryStreams(&firehose.ListDeliveryStreamsInput{ExclusiveStartDeliveryStreamName: aws.String("stream2")}).Return(&firehose.ListDeliveryStreamsOutput{
+			DeliveryStreamNames:    []*string{aws.String("stream3")},
+			HasMoreDeliveryStreams: aws.Bool(false),
+		}, nil),
+	)
+
+	streams, err := ListFirehoseStreamsForDeletion(mocks.mockAWSClient, mocks.Logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"stream1", "stream2", "stream3"}; !reflect.DeepEqual(aws.StringValueSlice(streams), expected) {
+		t.Errorf("expected %v, got %v", expected, aws.StringValueSlice(streams))
+	}
+}
+
+func TestDeleteFirehoseStreams(t *testing.T) {
+	testCases := []struct {
+		title              string
+		setupAWSMock       func(r *mock.MockClientMockRecorder)
+		streamsToBeDeleted []*string
+		errorExpected      bool
+	}{
+		{
+			title: "test 1 - No streams passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			streamsToBeDeleted: nil,
+			errorExpected:      false,
+		}, {
+			title: "test 2 - streams are force deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteDeliveryStream(&firehose.DeleteDeliveryStreamInput{DeliveryStreamName: aws.String("stream1"), AllowForceDelete: aws.Bool(true)}).Return(&firehose.DeleteDeliveryStreamOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			streamsToBeDeleted: []*string{aws.String("stream1")},
+			errorExpected:      false,
+		}, {
+			title: "test 3 - stream fails to delete",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteDeliveryStream(gomock.Any()).Return(nil, errors.New("ERROR")).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			streamsToBeDeleted: []*string{aws.String("stream1")},
+			errorExpected:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteFirehoseStreams(mocks.mockAWSClient, tc.streamsToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestDeleteSecrets(t *testing.T) {
+	testCases := []struct {
+		title              string
+		setupAWSMock       func(r *mock.MockClientMockRecorder)
+		secretsToBeDeleted []*string
+		recoveryWindowDays int64
+		errorExpected      bool
+	}{
+		{
+			title: "test 1 - No secrets passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			secretsToBeDeleted: nil,
+			errorExpected:      false,
+		}, {
+			title: "test 2 - secret force deleted without a recovery window",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteSecret(&secretsmanager.DeleteSecretInput{SecretId: aws.String("secret1"), ForceDeleteWithoutRecovery: aws.Bool(true)}).Return(&secretsmanager.DeleteSecretOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			secretsToBeDeleted: []*string{aws.String("secret1")},
+			recoveryWindowDays: 0,
+			errorExpected:      false,
+		}, {
+			title: "test 3 - secret deleted with the recovery window",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteSecret(&secretsmanager.DeleteSecretInput{SecretId: aws.String("secret1"), RecoveryWindowInDays: aws.Int64(7)}).Return(&secretsmanager.DeleteSecretOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			secretsToBeDeleted: []*string{aws.String("secret1")},
+			recoveryWindowDays: 7,
+			errorExpected:      false,
+		}, {
+			title: "test 4 - secret fails to delete",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteSecret(gomock.Any()).Return(nil, errors.New("ERROR")).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			secretsToBeDeleted: []*string{aws.String("secret1")},
+			errorExpected:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteSecrets(mocks.mockAWSClient, tc.secretsToBeDeleted, tc.recoveryWindowDays, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestDeleteSsmParameters(t *testing.T) {
+	testCases := []struct {
+		title                 string
+		setupAWSMock          func(r *mock.MockClientMockRecorder)
+		parametersToBeDeleted []*string
+		errorExpected         bool
+	}{
+		{
+			title: "test 1 - No parameters passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			parametersToBeDeleted: nil,
+			errorExpected:         false,
+		}, {
+			title: "test 2 - parameters are deleted in batches of 10",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteParameters(gomock.Any()).Return(&ssm.DeleteParametersOutput{}, nil).Times(3)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			parametersToBeDeleted: createInstanceList(25),
+			errorExpected:         false,
+		}, {
+			title: "test 3 - one batch fails",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteParameters(gomock.Any()).Return(nil, errors.New("ERROR")).Times(1)
+				r.DeleteParameters(gomock.Any()).Return(&ssm.DeleteParametersOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			parametersToBeDeleted: createInstanceList(15),
+			errorExpected:         true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteSsmParameters(mocks.mockAWSClient, tc.parametersToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestListCacheReplicationGroupsForDeletion(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	mocks.mockAWSClient.EXPECT().DescribeReplicationGroups(gomock.Any()).Return(&elasticache.DescribeReplicationGroupsOutput{ReplicationGroups: []*elasticache.ReplicationGroup{
+		{ReplicationGroupId: aws.String("available"), Status: aws.String("available")},
+		{ReplicationGroupId: aws.String("deleting"), Status: aws.String("deleting")},
+	}}, nil).Times(1)
+
+	groups, err := ListCacheReplicationGroupsForDeletion(mocks.mockAWSClient, mocks.Logger)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(aws.StringValueSlice(groups), []string{"available"}) {
+		t.Errorf("expected only the available replication group, got %v", aws.StringValueSlice(groups))
+	}
+}
+
+func TestDeleteRedshiftClusters(t *testing.T) {
+	testCases := []struct {
+		title               string
+		setupAWSMock        func(r *mock.MockClientMockRecorder)
+		clustersToBeDeleted []*string
+		errorExpected       bool
+	}{
+		{
+			title: "test 1 - No clusters passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			clustersToBeDeleted: nil,
+			errorExpected:       false,
+		}, {
+			title: "test 2 - cluster deleted without a final snapshot",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteClusterRedshift(&redshift.DeleteClusterInput{ClusterIdentifier: aws.String("cluster1"), SkipFinalClusterSnapshot: aws.Bool(true)}).Return(&redshift.DeleteClusterOutput{}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			clustersToBeDeleted: []*string{aws.String("cluster1")},
+			errorExpected:       false,
+		}, {
+			title: "test 3 - cluster fails to delete",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DeleteClusterRedshift(gomock.Any()).Return(nil, errors.New("ERROR")).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			clustersToBeDeleted: []*string{aws.String("cluster1")},
+			errorExpected:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteRedshiftClusters(mocks.mockAWSClient, tc.clustersToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestListRedshiftSubnetGroupsForDeletion(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	mocks.mockAWSClient.EXPECT().DescribeClusterSubnetGroups(gomock.Any()).Return(&redshift.DescribeClusterSubnetGroupsOutput{ClusterSubnetGroups: []*redshift.ClusterSubnetGroup{
+		{ClusterSubnetGroupName: aws.String("default")},
+		{ClusterSubnetGroupName: aws.String("custom")},
+	}}, nil).Times(1)
+
+	subnetGroups, err := ListRedshiftSubnetGroupsForDeletion(mocks.mockAWSClient, mocks.Logger)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(aws.StringValueSlice(subnetGroups), []string{"custom"}) {
+		t.Errorf("expected the default subnet group to be skipped, got %v", aws.StringValueSlice(subnetGroups))
+	}
+}
+
+func TestDeleteLoadBalancersV2(t *testing.T) {
+	testCases := []struct {
+		title                    string
+		setupAWSMock             func(r *mock.MockClientMockRecorder)
+		loadBalancersToBeDeleted []*string
+		errorExpected            bool
+	}{
+		{
+			title: "test 1 - No load balancers passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			loadBalancersToBeDeleted: nil,
+			errorExpected:            false,
+		}, {
+			title: "test 2 - deletion protection is disabled before deleting",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				gomock.InOrder(
+					r.ModifyLoadBalancerAttributes2(&elbv2.ModifyLoadBalancerAttributesInput{
+						LoadBalancerArn: aws.String("lb1"),
+						Attributes:      []*elbv2.LoadBalancerAttribute{{Key: aws.String("deletion_protection.enabled"), Value: aws.String("false")}},
+					}).Return(&elbv2.ModifyLoadBalancerAttributesOutput{}, nil).Times(1),
+					r.DeleteLoadBalancer2(&elbv2.DeleteLoadBalancerInput{LoadBalancerArn: aws.String("lb1")}).Return(&elbv2.DeleteLoadBalancerOutput{}, nil).Times(1),
+				)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			loadBalancersToBeDeleted: []*string{aws.String("lb1")},
+			errorExpected:            false,
+		}, {
+			title: "test 3 - delete is still attempted when the attribute can not be changed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.ModifyLoadBalancerAttributes2(gomock.Any()).Return(nil, errors.New("ERROR")).Times(1)
+				r.DeleteLoadBalancer2(gomock.Any()).Return(nil, errors.New("ERROR")).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			loadBalancersToBeDeleted: []*string{aws.String("lb1")},
+			errorExpected:            true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteLoadBalancersV2(mocks.mockAWSClient, tc.loadBalancersToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestListOrphanTargetGroupsForDeletion(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	mocks.mockAWSClient.EXPECT().DescribeTargetGroups(gomock.Any()).Return(&elbv2.DescribeTargetGroupsOutput{TargetGroups: []*elbv2.TargetGroup{
+		{TargetGroupArn: aws.String("in-use"), LoadBalancerArns: []*string{aws.String("lb1")}},
+		{TargetGroupArn: aws.String("orphan")},
+	}}, nil).Times(1)
+
+	targetGroups, err := ListOrphanTargetGroupsForDeletion(mocks.mockAWSClient, mocks.Logger)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(aws.StringValueSlice(targetGroups), []string{"orphan"}) {
+		t.Errorf("expected only the orphan target group, got %v", aws.StringValueSlice(targetGroups))
+	}
+}
+
+func TestDeleteTransitGatewayAttachments(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	r.DescribeTransitGatewayAttachments(gomock.Any()).Return(&ec2.DescribeTransitGatewayAttachmentsOutput{TransitGatewayAttachments: []*ec2.TransitGatewayAttachment{
+		{TransitGatewayAttachmentId: aws.String("vpc"), ResourceType: aws.String(ec2.TransitGatewayAttachmentResourceTypeVpc), State: aws.String(ec2.TransitGatewayAttachmentStateAvailable)},
+		{TransitGatewayAttachmentId: aws.String("peering"), ResourceType: aws.String(ec2.TransitGatewayAttachmentResourceTypeTgwPeering), State: aws.String(ec2.TransitGatewayAttachmentStateAvailable)},
+		{TransitGatewayAttachmentId: aws.String("vpn"), ResourceType: aws.String(ec2.TransitGatewayAttachmentResourceTypeVpn), State: aws.String(ec2.TransitGatewayAttachmentStateAvailable)},
+		{TransitGatewayAttachmentId: aws.String("deleting"), ResourceType: aws.String(ec2.TransitGatewayAttachmentResourceTypeVpc), State: aws.String(ec2.TransitGatewayAttachmentStateDeleting)},
+	}}, nil).Times(1)
+	r.DeleteTransitGatewayVpcAttachment(&ec2.DeleteTransitGatewayVpcAttachmentInput{TransitGatewayAttachmentId: aws.String("vpc")}).Return(&ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil).Times(1)
+	r.DeleteTransitGatewayPeeringAttachment(&ec2.DeleteTransitGatewayPeeringAttachmentInput{TransitGatewayAttachmentId: aws.String("peering")}).Return(&ec2.DeleteTransitGatewayPeeringAttachmentOutput{}, nil).Times(1)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := DeleteTransitGatewayAttachments(mocks.mockAWSClient, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteEgressOnlyInternetGateways(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+
+	attached := []*ec2.InternetGatewayAttachment{{VpcId: aws.String("vpc-1")}}
+	gomock.InOrder(
+		r.DescribeEgressOnlyInternetGateways(&ec2.DescribeEgressOnlyInternetGatewaysInput{
+			Filters: []*ec2.Filter{{Name: aws.String("attachment.vpc-id"), Values: []*string{aws.String("vpc-1")}}},
+		}).Return(&ec2.DescribeEgressOnlyInternetGatewaysOutput{
+			EgressOnlyInternetGateways: []*ec2.EgressOnlyInternetGateway{
+				{EgressOnlyInternetGatewayId: aws.String("eigw-1"), Attachments: attached},
+				{EgressOnlyInternetGatewayId: aws.String("eigw-2"), Attachments: attached},
+			},
+		}, nil),
+		r.DeleteEgressOnlyInternetGateway(&ec2.DeleteEgressOnlyInternetGatewayInput{EgressOnlyInternetGatewayId: aws.String("eigw-1")}).Return(nil, errors.New("ERROR")),
+		r.DeleteEgressOnlyInternetGateway(&ec2.DeleteEgressOnlyInternetGatewayInput{EgressOnlyInternetGatewayId: aws.String("eigw-2")}).Return(&ec2.DeleteEgressOnlyInternetGatewayOutput{}, nil),
+	)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := DeleteEgressOnlyInternetGateways(mocks.mockAWSClient, aws.String("vpc-1"), mocks.Logger); err == nil {
+		t.Error("expected an error for the gateway that could not be deleted")
+	}
+}
+
+func TestDeleteManagedPrefixLists(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+
+	gomock.InOrder(
+		r.GetCallerIdentity(gomock.Any()).Return(&sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil),
+		r.DescribeManagedPrefixLists(&ec2.DescribeManagedPrefixListsInput{
+			Filters: []*ec2.Filter{{Name: aws.String("owner-id"), Values: []*string{aws.String("123456789012")}}},
+		}).Return(&ec2.DescribeManagedPrefixListsOutput{
+			PrefixLists: []*ec2.ManagedPrefixList{
+				{PrefixListId: aws.String("pl-aws"), OwnerId: aws.String("AWS"), State: aws.String(ec2.PrefixListStateCreateComplete)},
+				{PrefixListId: aws.String("pl-deleting"), OwnerId: aws.String("123456789012"), State: aws.String(ec2.PrefixListStateDeleteInProgress)},
+				{PrefixListId: aws.String("pl-custom"), OwnerId: aws.String("123456789012"), State: aws.String(ec2.PrefixListStateCreateComplete)},
+			},
+		}, nil),
+		r.DeleteManagedPrefixList(&ec2.DeleteManagedPrefixListInput{PrefixListId: aws.String("pl-custom")}).Return(&ec2.DeleteManagedPrefixListOutput{}, nil),
+	)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := DeleteManagedPrefixLists(mocks.mockAWSClient, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteVpnGateways(t *testing.T) {
+	testCases := []struct {
+		title         string
+		setupAWSMock  func(r *mock.MockClientMockRecorder)
+		errorExpected bool
+	}{
+		{
+			title: "test 1 - attached gateway is detached then deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeVpnGateways(gomock.Any()).Return(&ec2.DescribeVpnGatewaysOutput{VpnGateways: []*ec2.VpnGateway{{
+					VpnGatewayId:   aws.String("vgw"),
+					State:          aws.String(ec2.VpnStateAvailable),
+					VpcAttachments: []*ec2.VpcAttachment{{VpcId: aws.String("vpc"), State: aws.String(ec2.AttachmentStatusAttached)}},
+				}}}, nil).Times(1)
+				gomock.InOrder(
+					r.DetachVpnGateway(&ec2.DetachVpnGatewayInput{VpcId: aws.String("vpc"), VpnGatewayId: aws.String("vgw")}).Return(&ec2.DetachVpnGatewayOutput{}, nil).Times(1),
+					r.DeleteVpnGateway(&ec2.DeleteVpnGatewayInput{VpnGatewayId: aws.String("vgw")}).Return(&ec2.DeleteVpnGatewayOutput{}, nil).Times(1),
+				)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			errorExpected: false,
+		}, {
+			title: "test 2 - deleted gateways are skipped",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeVpnGateways(gomock.Any()).Return(&ec2.DescribeVpnGatewaysOutput{VpnGateways: []*ec2.VpnGateway{{
+					VpnGatewayId: aws.String("vgw"),
+					State:        aws.String(ec2.VpnStateDeleted),
+				}}}, nil).Times(1)
+			},
+			errorExpected: false,
+		}, {
+			title: "test 3 - gateway still detaching fails to delete",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeVpnGateways(gomock.Any()).Return(&ec2.DescribeVpnGatewaysOutput{VpnGateways: []*ec2.VpnGateway{{
+					VpnGatewayId:   aws.String("vgw"),
+					State:          aws.String(ec2.VpnStateAvailable),
+					VpcAttachments: []*ec2.VpcAttachment{{VpcId: aws.String("vpc"), State: aws.String(ec2.AttachmentStatusDetaching)}},
+				}}}, nil).Times(1)
+				r.DeleteVpnGateway(gomock.Any()).Return(nil, errors.New("ERROR")).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteVpnGateways(mocks.mockAWSClient, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestListVpcPeeringConnectionsForDeletion(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	mocks.mockAWSClient.EXPECT().DescribeVpcPeeringConnections(gomock.Any()).Return(&ec2.DescribeVpcPeeringConnectionsOutput{VpcPeeringConnections: []*ec2.VpcPeeringConnection{
+		{VpcPeeringConnectionId: aws.String("active"), Status: &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeActive)}},
+		{VpcPeeringConnectionId: aws.String("pending"), Status: &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance)}},
+		{VpcPeeringConnectionId: aws.String("deleted"), Status: &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeDeleted)}},
+	}}, nil).Times(1)
+
+	peeringConnections, err := ListVpcPeeringConnectionsForDeletion(mocks.mockAWSClient, mocks.Logger)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(aws.StringValueSlice(peeringConnections), []string{"active", "pending"}) {
+		t.Errorf("expected the active and pending connections, got %v", aws.StringValueSlice(peeringConnections))
+	}
+}
+
+func TestDisassociateSecondaryVpcCidrBlocks(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	associated := &ec2.VpcCidrBlockState{State: aws.String(ec2.VpcCidrBlockStateCodeAssociated)}
+	r.DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{{
+		VpcId:     aws.String("vpc"),
+		CidrBlock: aws.String("10.0.0.0/16"),
+		CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+			{AssociationId: aws.String("primary"), CidrBlock: aws.String("10.0.0.0/16"), CidrBlockState: associated},
+			{AssociationId: aws.String("secondary"), CidrBlock: aws.String("10.1.0.0/16"), CidrBlockState: associated},
+		},
+		Ipv6CidrBlockAssociationSet: []*ec2.VpcIpv6CidrBlockAssociation{
+			{AssociationId: aws.String("ipv6"), Ipv6CidrBlockState: associated},
+		},
+	}}}, nil).Times(1)
+	r.DisassociateVpcCidrBlock(&ec2.DisassociateVpcCidrBlockInput{AssociationId: aws.String("secondary")}).Return(&ec2.DisassociateVpcCidrBlockOutput{}, nil).Times(1)
+	r.DisassociateVpcCidrBlock(&ec2.DisassociateVpcCidrBlockInput{AssociationId: aws.String("ipv6")}).Return(&ec2.DisassociateVpcCidrBlockOutput{}, nil).Times(1)
+
+	if err := DisassociateSecondaryVpcCidrBlocks(mocks.mockAWSClient, aws.String("vpc"), mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCleanDhcpOptions(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	r.DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc"), DhcpOptionsId: aws.String("in-use")}}}, nil).Times(1)
+	r.DescribeDhcpOptions(gomock.Any()).Return(&ec2.DescribeDhcpOptionsOutput{DhcpOptions: []*ec2.DhcpOptions{
+		{DhcpOptionsId: aws.String("in-use")},
+		{DhcpOptionsId: aws.String("aws-default"), DhcpConfigurations: []*ec2.DhcpConfiguration{
+			{Key: aws.String("domain-name"), Values: []*ec2.AttributeValue{{Value: aws.String("eu-west-1.compute.internal")}}},
+		}},
+		{DhcpOptionsId: aws.String("custom"), DhcpConfigurations: []*ec2.DhcpConfiguration{
+			{Key: aws.String("domain-name"), Values: []*ec2.AttributeValue{{Value: aws.String("corp.example.com")}}},
+		}},
+	}}, nil).Times(1)
+	r.DeleteDhcpOptions(&ec2.DeleteDhcpOptionsInput{DhcpOptionsId: aws.String("custom")}).Return(&ec2.DeleteDhcpOptionsOutput{}, nil).Times(1)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := CleanDhcpOptions(mocks.mockAWSClient, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCleanVpcInstancesRunsEveryStep(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	gomock.InOrder(
+		r.DescribeVpcs(gomock.Any()).Return(nil, errors.New("RequestLimitExceeded")).Times(1),
+		r.DescribeVpnConnections(gomock.Any()).Return(&ec2.DescribeVpnConnectionsOutput{}, nil).Times(1),
+		r.DescribeVpnGateways(gomock.Any()).Return(nil, errors.New("RequestLimitExceeded")).Times(1),
+		r.DescribeCustomerGateways(gomock.Any()).Return(&ec2.DescribeCustomerGatewaysOutput{}, nil).Times(1),
+		r.GetCallerIdentity(gomock.Any()).Return(&sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil).Times(1),
+		r.DescribeManagedPrefixLists(gomock.Any()).Return(&ec2.DescribeManagedPrefixListsOutput{}, nil).Times(1),
+	)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := CleanVpcInstances(mocks.mockAWSClient, 0, mocks.Logger); err == nil {
+		t.Error("expected the failed steps to be reported")
+	}
+}
+
+func TestDeleteVpcEndpointServices(t *testing.T) {
+	testCases := []struct {
+		title               string
+		setupAWSMock        func(r *mock.MockClientMockRecorder)
+		servicesToBeDeleted []*string
+		errorExpected       bool
+	}{
+		{
+			title: "test 1 - No services passed",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+
+			},
+			servicesToBeDeleted: nil,
+			errorExpected:       false,
+		}, {
+			title: "test 2 - connections are rejected before the service is deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeVpcEndpointConnections(gomock.Any()).Return(&ec2.DescribeVpcEndpointConnectionsOutput{VpcEndpointConnections: []*ec2.VpcEndpointConnection{
+					{VpcEndpointId: aws.String("vpce"), VpcEndpointState: aws.String(ec2.StateAvailable)},
+				}}, nil).Times(1)
+				gomock.InOrder(
+					r.RejectVpcEndpointConnections(&ec2.RejectVpcEndpointConnectionsInput{ServiceId: aws.String("svc"), VpcEndpointIds: []*string{aws.String("vpce")}}).Return(&ec2.RejectVpcEndpointConnectionsOutput{}, nil).Times(1),
+					r.DeleteVpcEndpointServiceConfigurations(gomock.Any()).Return(&ec2.DeleteVpcEndpointServiceConfigurationsOutput{}, nil).Times(1),
+				)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			servicesToBeDeleted: []*string{aws.String("svc")},
+			errorExpected:       false,
+		}, {
+			title: "test 3 - service reported as unsuccessful",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeVpcEndpointConnections(gomock.Any()).Return(&ec2.DescribeVpcEndpointConnectionsOutput{}, nil).Times(1)
+				r.DeleteVpcEndpointServiceConfigurations(gomock.Any()).Return(&ec2.DeleteVpcEndpointServiceConfigurationsOutput{Unsuccessful: []*ec2.UnsuccessfulItem{
+					{ResourceId: aws.String("svc"), Error: &ec2.UnsuccessfulItemError{Code: aws.String("ExistingVpcEndpointConnections")}},
+				}}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			servicesToBeDeleted: []*string{aws.String("svc")},
+			errorExpected:       true,
+		}, {
+			title: "test 4 - failed rejection is reported even when the service is deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				gomock.InOrder(
+					r.DescribeVpcEndpointConnections(gomock.Any()).Return(nil, errors.New("RequestLimitExceeded")).Times(1),
+					r.DeleteVpcEndpointServiceConfigurations(gomock.Any()).Return(&ec2.DeleteVpcEndpointServiceConfigurationsOutput{}, nil).Times(1),
+				)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			servicesToBeDeleted: []*string{aws.String("svc")},
+			errorExpected:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := DeleteVpcEndpointServices(mocks.mockAWSClient, tc.servicesToBeDeleted, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestRecreateDefaultVpc(t *testing.T) {
+	testCases := []struct {
+		title         string
+		setupAWSMock  func(r *mock.MockClientMockRecorder)
+		errorExpected bool
+	}{
+		{
+			title: "test 1 - region without a default VPC gets a new one",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{}, nil).Times(1)
+				r.CreateDefaultVpc(gomock.Any()).Return(&ec2.CreateDefaultVpcOutput{Vpc: &ec2.Vpc{VpcId: aws.String("vpc-new")}}, nil).Times(1)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			errorExpected: false,
+		}, {
+			title: "test 2 - no new VPC while the old one can not be deleted",
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-old"), IsDefault: aws.Bool(true)}}}, nil).AnyTimes()
+				r.DescribeFlowLogs(gomock.Any()).Return(&ec2.DescribeFlowLogsOutput{}, nil).AnyTimes()
+				r.DescribeVpcEndpoints(gomock.Any()).Return(&ec2.DescribeVpcEndpointsOutput{}, nil).AnyTimes()
+				r.DescribeLoadBalancers(gomock.Any()).Return(&elb.DescribeLoadBalancersOutput{}, nil).AnyTimes()
+				r.DescribeLoadBalancers2(gomock.Any()).Return(&elbv2.DescribeLoadBalancersOutput{}, nil).AnyTimes()
+				r.DescribeNatGateways(gomock.Any()).Return(&ec2.DescribeNatGatewaysOutput{}, nil).AnyTimes()
+				r.DescribeNetworkInterfaces(gomock.Any()).Return(&ec2.DescribeNetworkInterfacesOutput{}, nil).AnyTimes()
+				r.DescribeInternetGateways(gomock.Any()).Return(&ec2.DescribeInternetGatewaysOutput{}, nil).AnyTimes()
+				r.DescribeEgressOnlyInternetGateways(gomock.Any()).Return(&ec2.DescribeEgressOnlyInternetGatewaysOutput{}, nil).AnyTimes()
+				r.DescribeCarrierGateways(gomock.Any()).Return(&ec2.DescribeCarrierGatewaysOutput{}, nil).AnyTimes()
+				r.DescribeVpnGateways(gomock.Any()).Return(&ec2.DescribeVpnGatewaysOutput{}, nil).AnyTimes()
+				r.DescribeNetworkAcls(gomock.Any()).Return(&ec2.DescribeNetworkAclsOutput{}, nil).AnyTimes()
+				r.DescribeRouteTables(gomock.Any()).Return(&ec2.DescribeRouteTablesOutput{}, nil).AnyTimes()
+				r.DescribeSubnets(gomock.Any()).Return(&ec2.DescribeSubnetsOutput{}, nil).AnyTimes()
+				r.DescribeSecurityGroups(gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{}, nil).AnyTimes()
+				r.DeleteVpc(gomock.Any()).Return(nil, errors.New("DependencyViolation")).Times(1)
+				r.CreateDefaultVpc(gomock.Any()).Times(0)
+				r.GetRegion().Return("Region1").AnyTimes()
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			mockExecution := RecreateDefaultVpc(mocks.mockAWSClient, 0, mocks.Logger)
+
+			if (mockExecution != nil) != tc.errorExpected {
+				t.Errorf(tc.title, "Failed")
+			}
+		})
+	}
+}
+
+func TestDeleteNonDefaultSubnets(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	r.DescribeSubnets(gomock.Any()).Return(&ec2.DescribeSubnetsOutput{Subnets: []*ec2.Subnet{
+		{SubnetId: aws.String("default"), DefaultForAz: aws.Bool(true)},
+		{SubnetId: aws.String("custom"), DefaultForAz: aws.Bool(false)},
+	}}, nil).Times(1)
+	r.DeleteSubnet(&ec2.DeleteSubnetInput{SubnetId: aws.String("custom")}).Return(&ec2.DeleteSubnetOutput{}, nil).Times(1)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := deleteNonDefaultSubnets(mocks.mockAWSClient, aws.String("vpc"), mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteNonMainRouteTables(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	r.DescribeRouteTables(gomock.Any()).Return(&ec2.DescribeRouteTablesOutput{RouteTables: []*ec2.RouteTable{
+		{RouteTableId: aws.String("main"), Associations: []*ec2.RouteTableAssociation{{RouteTableAssociationId: aws.String("main-assoc"), Main: aws.Bool(true)}}},
+		{RouteTableId: aws.String("custom"), Associations: []*ec2.RouteTableAssociation{{RouteTableAssociationId: aws.String("custom-assoc"), Main: aws.Bool(false)}}},
+	}}, nil).Times(1)
+	r.DisassociateRouteTable(&ec2.DisassociateRouteTableInput{AssociationId: aws.String("custom-assoc")}).Return(&ec2.DisassociateRouteTableOutput{}, nil).Times(1)
+	r.DeleteRouteTable(&ec2.DeleteRouteTableInput{RouteTableId: aws.String("custom")}).Return(&ec2.DeleteRouteTableOutput{}, nil).Times(1)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := deleteNonMainRouteTables(mocks.mockAWSClient, aws.String("vpc"), mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestScrubDefaultSecurityGroup(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	securityGroup := &ec2.SecurityGroup{
+		GroupId:   aws.String("sg-default"),
+		GroupName: aws.String("default"),
+		IpPermissions: []*ec2.IpPermission{
+			{IpProtocol: aws.String("-1"), UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-default")}, {GroupId: aws.String("sg-other")}}},
+		},
+		IpPermissionsEgress: []*ec2.IpPermission{
+			{IpProtocol: aws.String("-1"), IpRanges: []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}},
+		},
+	}
+	r.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+		GroupId:       aws.String("sg-default"),
+		IpPermissions: []*ec2.IpPermission{{IpProtocol: aws.String("-1"), UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-other")}}}},
+	}).Return(&ec2.RevokeSecurityGroupIngressOutput{}, nil).Times(1)
+	r.RevokeSecurityGroupEgress(gomock.Any()).Times(0)
+
+	if err := ScrubDefaultSecurityGroup(mocks.mockAWSClient, securityGroup, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestResetDefaultNetworkAcl(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	acl := &ec2.NetworkAcl{
+		NetworkAclId: aws.String("acl-default"),
+		VpcId:        aws.String("vpc"),
+		IsDefault:    aws.Bool(true),
+		Entries: []*ec2.NetworkAclEntry{
+			{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: aws.String("allow"), CidrBlock: aws.String("0.0.0.0/0")},
+			{RuleNumber: aws.Int64(32767), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), CidrBlock: aws.String("0.0.0.0/0")},
+			{RuleNumber: aws.Int64(100), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), CidrBlock: aws.String("0.0.0.0/0")},
+			{RuleNumber: aws.Int64(32767), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), CidrBlock: aws.String("0.0.0.0/0")},
+		},
+	}
+	r.DeleteNetworkAclEntry(&ec2.DeleteNetworkAclEntryInput{NetworkAclId: aws.String("acl-default"), Egress: aws.Bool(true), RuleNumber: aws.Int64(100)}).Return(&ec2.DeleteNetworkAclEntryOutput{}, nil).Times(1)
+	r.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: []*string{aws.String("vpc")}}).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc")}}}, nil).Times(1)
+	r.CreateNetworkAclEntry(gomock.Any()).DoAndReturn(func(input *ec2.CreateNetworkAclEntryInput) (*ec2.CreateNetworkAclEntryOutput, error) {
+		if !aws.BoolValue(input.Egress) || aws.StringValue(input.RuleAction) != "allow" {
+			t.Errorf("unexpected entry restored: %v", input)
+		}
+		return &ec2.CreateNetworkAclEntryOutput{}, nil
+	}).Times(1)
+
+	if err := ResetDefaultNetworkAcl(mocks.mockAWSClient, acl, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestResetDefaultNetworkAclRestoresIpv6Entries(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	acl := &ec2.NetworkAcl{
+		NetworkAclId: aws.String("acl-default"),
+		VpcId:        aws.String("vpc"),
+		IsDefault:    aws.Bool(true),
+		Entries: []*ec2.NetworkAclEntry{
+			{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: aws.String("allow"), CidrBlock: aws.String("0.0.0.0/0")},
+			{RuleNumber: aws.Int64(100), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: aws.String("allow"), CidrBlock: aws.String("0.0.0.0/0")},
+			{RuleNumber: aws.Int64(101), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: aws.String("allow"), Ipv6CidrBlock: aws.String("::/0")},
+			{RuleNumber: aws.Int64(32768), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), Ipv6CidrBlock: aws.String("::/0")},
+			{RuleNumber: aws.Int64(32768), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: aws.String("deny"), Ipv6CidrBlock: aws.String("::/0")},
+		},
+	}
+	r.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: []*string{aws.String("vpc")}}).Return(&ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{{
+		VpcId: aws.String("vpc"),
+		Ipv6CidrBlockAssociationSet: []*ec2.VpcIpv6CidrBlockAssociation{
+			{Ipv6CidrBlock: aws.String("2600:1f18::/56"), Ipv6CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String(ec2.VpcCidrBlockStateCodeAssociated)}},
+		},
+	}}}, nil).Times(1)
+	r.CreateNetworkAclEntry(&ec2.CreateNetworkAclEntryInput{
+		NetworkAclId:  aws.String("acl-default"),
+		Egress:        aws.Bool(false),
+		RuleNumber:    aws.Int64(101),
+		Protocol:      aws.String("-1"),
+		RuleAction:    aws.String("allow"),
+		Ipv6CidrBlock: aws.String("::/0"),
+	}).Return(&ec2.CreateNetworkAclEntryOutput{}, nil).Times(1)
+
+	if err := ResetDefaultNetworkAcl(mocks.mockAWSClient, acl, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteSecurityGroupsReportsDefaultScrubFailure(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	r.DescribeSecurityGroups(gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{SecurityGroups: []*ec2.SecurityGroup{{
+		GroupId:   aws.String("sg-default"),
+		GroupName: aws.String("default"),
+		VpcId:     aws.String("vpc"),
+		IpPermissions: []*ec2.IpPermission{
+			{IpProtocol: aws.String("-1"), IpRanges: []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}},
+		},
+	}}}, nil).Times(1)
+	r.RevokeSecurityGroupIngress(gomock.Any()).Return(nil, errors.New("UnauthorizedOperation")).Times(1)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := DeleteSecurityGroups(mocks.mockAWSClient, aws.String("vpc"), mocks.Logger); err == nil {
+		t.Error("expected the failed scrub of the default security group to be reported")
+	}
+}
+
+func TestDeleteRouteTablesScrubsMainRouteTable(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	r.DescribeRouteTables(gomock.Any()).Return(&ec2.DescribeRouteTablesOutput{RouteTables: []*ec2.RouteTable{
+		{
+			RouteTableId: aws.String("main"),
+			VpcId:        aws.String("vpc"),
+			Associations: []*ec2.RouteTableAssociation{{RouteTableAssociationId: aws.String("main-assoc"), Main: aws.Bool(true)}},
+			Routes: []*ec2.Route{
+				{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local"), Origin: aws.String("CreateRouteTable")},
+				{DestinationCidrBlock: aws.String("10.1.0.0/16"), VpcPeeringConnectionId: aws.String("pcx"), Origin: aws.String("CreateRoute")},
+				{DestinationCidrBlock: aws.String("10.2.0.0/16"), GatewayId: aws.String("vgw"), Origin: aws.String("EnableVgwRoutePropagation")},
+				{DestinationPrefixListId: aws.String("pl-s3"), GatewayId: aws.String("vpce-1"), Origin: aws.String("CreateRoute")},
+				{DestinationPrefixListId: aws.String("pl-custom"), TransitGatewayId: aws.String("tgw-1"), Origin: aws.String("CreateRoute")},
+			},
+		},
+	}}, nil).Times(1)
+	r.DisassociateRouteTable(gomock.Any()).Times(0)
+	r.DeleteRouteTable(gomock.Any()).Times(0)
+	r.DeleteRoute(&ec2.DeleteRouteInput{RouteTableId: aws.String("main"), DestinationCidrBlock: aws.String("10.1.0.0/16")}).Return(&ec2.DeleteRouteOutput{}, nil).Times(1)
+	r.DeleteRoute(&ec2.DeleteRouteInput{RouteTableId: aws.String("main"), DestinationPrefixListId: aws.String("pl-custom")}).Return(&ec2.DeleteRouteOutput{}, nil).Times(1)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := DeleteRouteTables(mocks.mockAWSClient, aws.String("vpc"), mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestEmptyS3Bucket(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	bucket := aws.String("bucket")
+	r.GetBucketPolicy(gomock.Any()).Return(&s3.GetBucketPolicyOutput{Policy: aws.String(`{"Statement":{"Effect":"Deny","Action":"s3:DeleteObject"}}`)}, nil).Times(1)
+	r.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{Bucket: bucket}).Return(&s3.DeleteBucketPolicyOutput{}, nil).Times(1)
+	r.GetBucketVersioning(gomock.Any()).Return(&s3.GetBucketVersioningOutput{Status: aws.String("Enabled")}, nil).Times(1)
+	r.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket:                  bucket,
+		VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String("Suspended")},
+	}).Return(&s3.PutBucketVersioningOutput{}, nil).Times(1)
+	r.ListMultipartUploads(gomock.Any()).Return(&s3.ListMultipartUploadsOutput{Uploads: []*s3.MultipartUpload{{Key: aws.String("upload"), UploadId: aws.String("id")}}}, nil).Times(1)
+	r.AbortMultipartUpload(&s3.AbortMultipartUploadInput{Bucket: bucket, Key: aws.String("upload"), UploadId: aws.String("id")}).Return(&s3.AbortMultipartUploadOutput{}, nil).Times(1)
+	r.GetObjectLockConfiguration(gomock.Any()).Return(nil, awserr.New("ObjectLockConfigurationNotFoundError", "", nil)).Times(1)
+	gomock.InOrder(
+		r.ListObjectVersions(gomock.Any()).Return(&s3.ListObjectVersionsOutput{
+			Versions:            []*s3.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}},
+			IsTruncated:         aws.Bool(true),
+			NextKeyMarker:       aws.String("a"),
+			NextVersionIdMarker: aws.String("1"),
+		}, nil),
+		r.DeleteObjects(gomock.Any()).Return(&s3.DeleteObjectsOutput{}, nil),
+		r.ListObjectVersions(gomock.Any()).Return(&s3.ListObjectVersionsOutput{
+			DeleteMarkers: []*s3.DeleteMarkerEntry{{Key: aws.String("a"), VersionId: aws.String("2")}},
+		}, nil),
+		r.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket:                    bucket,
+			Delete:                    &s3.Delete{Objects: []*s3.ObjectIdentifier{{Key: aws.String("a"), VersionId: aws.String("2")}}, Quiet: aws.Bool(true)},
+			BypassGovernanceRetention: aws.Bool(false),
+		}).Return(&s3.DeleteObjectsOutput{}, nil),
+	)
+
+	if err := EmptyS3Bucket(mocks.mockAWSClient, bucket, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPolicyHasDenyStatement(t *testing.T) {
+	testCases := []struct {
+		policy   string
+		expected bool
+	}{
+		{policy: `{"Statement":[{"Effect":"Allow"}]}`, expected: false},
+		{policy: `{"Statement":[{"Effect":"Allow"},{"Effect":"Deny"}]}`, expected: true},
+		{policy: `{"Statement":{"Effect":"Deny"}}`, expected: true},
+		{policy: `not a policy`, expected: true},
+	}
+
+	for _, tc := range testCases {
+		if policyHasDenyStatement(tc.policy) != tc.expected {
+			t.Errorf("policy %s: expected %v", tc.policy, tc.expected)
+		}
+	}
+}
+
+func TestCleanS3InstancesRoutesBucketsToTheirRegion(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	r.ListBuckets(gomock.Any()).Return(&s3.ListBucketsOutput{Buckets: []*s3.Bucket{{Name: aws.String("virginia")}, {Name: aws.String("ireland")}}}, nil).Times(1)
+	r.GetBucketLocation(&s3.GetBucketLocationInput{Bucket: aws.String("virginia")}).Return(&s3.GetBucketLocationOutput{}, nil).Times(1)
+	r.GetBucketLocation(&s3.GetBucketLocationInput{Bucket: aws.String("ireland")}).Return(&s3.GetBucketLocationOutput{LocationConstraint: aws.String("EU")}, nil).Times(1)
+	expectS3BucketTeardown(r)
+	r.DeleteBucket(gomock.Any()).Return(&s3.DeleteBucketOutput{}, nil).Times(2)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	var requestedRegions []string
+	regionalClient := func(region string) (clientpkg.Client, error) {
+		requestedRegions = append(requestedRegions, region)
+		return mocks.mockAWSClient, nil
+	}
+
+	if err := CleanS3Instances(mocks.mockAWSClient, regionalClient, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	sort.Strings(requestedRegions)
+	if !reflect.DeepEqual(requestedRegions, []string{"eu-west-1", "us-east-1"}) {
+		t.Errorf("unexpected regions requested: %v", requestedRegions)
+	}
+}
+
+func TestCleanS3InstancesLabelsMetricsGlobal(t *testing.T) {
+	globalMocks := setupDefaultMocks(t)
+	g := globalMocks.mockAWSClient.EXPECT()
+	g.ListBuckets(gomock.Any()).Return(&s3.ListBucketsOutput{Buckets: []*s3.Bucket{{Name: aws.String("ireland")}}}, nil).Times(1)
+	g.GetBucketLocation(gomock.Any()).Return(&s3.GetBucketLocationOutput{LocationConstraint: aws.String("EU")}, nil).Times(1)
+	g.GetRegion().Return(clientpkg.GlobalRegion).AnyTimes()
+
+	bucketMocks := setupDefaultMocks(t)
+	r := bucketMocks.mockAWSClient.EXPECT()
+	expectS3BucketTeardown(r)
+	r.DeleteBucket(gomock.Any()).Return(&s3.DeleteBucketOutput{}, nil).Times(1)
+	r.GetRegion().Return("eu-west-1").AnyTimes()
+
+	regionalClient := func(region string) (clientpkg.Client, error) {
+		return bucketMocks.mockAWSClient, nil
+	}
+	globalSuccess := localMetrics.Metrics.ResourceSuccess.WithLabelValues(localMetrics.S3Bucket, clientpkg.GlobalRegion)
+	regionalSuccess := localMetrics.Metrics.ResourceSuccess.WithLabelValues(localMetrics.S3Bucket, "eu-west-1")
+	globalBefore, regionalBefore := testutil.ToFloat64(globalSuccess), testutil.ToFloat64(regionalSuccess)
+
+	if err := CleanS3Instances(globalMocks.mockAWSClient, regionalClient, globalMocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if delta := testutil.ToFloat64(globalSuccess) - globalBefore; delta != 1 {
+		t.Errorf("expected 1 bucket counted under the global label, got %v", delta)
+	}
+	if delta := testutil.ToFloat64(regionalSuccess) - regionalBefore; delta != 0 {
+		t.Errorf("expected no bucket counted under the bucket region, got %v", delta)
+	}
+}
+
+func TestCleanS3InstancesFailsWhenBucketsCanNotBeListed(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	mocks.mockAWSClient.EXPECT().ListBuckets(gomock.Any()).Return(nil, errors.New("AccessDenied")).Times(1)
+
+	regionalClient := func(region string) (clientpkg.Client, error) {
+		t.Errorf("no regional client expected, got a request for %s", region)
+		return mocks.mockAWSClient, nil
+	}
+
+	if err := CleanS3Instances(mocks.mockAWSClient, regionalClient, mocks.Logger); err == nil {
+		t.Error("expected the ListBuckets error to be returned")
+	}
+}
+
+func TestDeleteS3BucketsUnderObjectLock(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	bucket := aws.String("locked")
+	retainUntil := time.Now().Add(24 * time.Hour).UTC()
+
+	r.GetCallerIdentity(gomock.Any()).Return(&sts.GetCallerIdentityOutput{Account: aws.String("123456789012")}, nil).AnyTimes()
+	r.DeleteBucketReplication(gomock.Any()).Return(&s3.DeleteBucketReplicationOutput{}, nil).AnyTimes()
+	r.DeleteBucketLifecycle(gomock.Any()).Return(&s3.DeleteBucketLifecycleOutput{}, nil).AnyTimes()
+	r.ListBucketInventoryConfigurations(gomock.Any()).Return(&s3.ListBucketInventoryConfigurationsOutput{}, nil).AnyTimes()
+	r.ListAccessPoints(gomock.Any()).Return(&s3control.ListAccessPointsOutput{}, nil).AnyTimes()
+	r.GetBucketPolicy(gomock.Any()).Return(nil, awserr.New("NoSuchBucketPolicy", "", nil)).AnyTimes()
+	r.GetBucketVersioning(gomock.Any()).Return(&s3.GetBucketVersioningOutput{Status: aws.String("Enabled")}, nil).AnyTimes()
+	r.PutBucketVersioning(gomock.Any()).Return(&s3.PutBucketVersioningOutput{}, nil).AnyTimes()
+	r.ListMultipartUploads(gomock.Any()).Return(&s3.ListMultipartUploadsOutput{}, nil).AnyTimes()
+	r.GetObjectLockConfiguration(gomock.Any()).Return(&s3.GetObjectLockConfigurationOutput{
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{ObjectLockEnabled: aws.String("Enabled")},
+	}, nil).Times(1)
+	r.ListObjectVersions(gomock.Any()).Return(&s3.ListObjectVersionsOutput{Versions: []*s3.ObjectVersion{
+		{Key: aws.String("governance"), VersionId: aws.String("1")},
+		{Key: aws.String("compliance"), VersionId: aws.String("2")},
+	}}, nil).Times(1)
+	r.PutObjectLegalHold(gomock.Any()).Return(&s3.PutObjectLegalHoldOutput{}, nil).Times(2)
+	r.GetObjectRetention(&s3.GetObjectRetentionInput{Bucket: bucket, Key: aws.String("governance"), VersionId: aws.String("1")}).Return(&s3.GetObjectRetentionOutput{
+		Retention: &s3.ObjectLockRetention{Mode: aws.String("GOVERNANCE"), RetainUntilDate: aws.Time(retainUntil)},
+	}, nil).Times(1)
+	r.GetObjectRetention(&s3.GetObjectRetentionInput{Bucket: bucket, Key: aws.String("compliance"), VersionId: aws.String("2")}).Return(&s3.GetObjectRetentionOutput{
+		Retention: &s3.ObjectLockRetention{Mode: aws.String("COMPLIANCE"), RetainUntilDate: aws.Time(retainUntil)},
+	}, nil).Times(1)
+	r.DeleteObjects(&s3.DeleteObjectsInput{
+		Bucket:                    bucket,
+		Delete:                    &s3.Delete{Objects: []*s3.ObjectIdentifier{{Key: aws.String("governance"), VersionId: aws.String("1")}}, Quiet: aws.Bool(true)},
+		BypassGovernanceRetention: aws.Bool(true),
+	}).Return(&s3.DeleteObjectsOutput{}, nil).Times(1)
+	r.DeleteBucket(gomock.Any()).Times(0)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	err := DeleteS3Buckets(mocks.mockAWSClient, []*string{bucket}, mocks.Logger)
+	var retentionErr *S3RetentionError
+	if !errors.As(err, &retentionErr) {
+		t.Fatalf("expected an S3RetentionError, got %v", err)
+	}
+	if !retentionErr.RetainUntil.Equal(retainUntil) {
+		t.Errorf("expected the bucket to be unshreddable until %v, got %v", retainUntil, retentionErr.RetainUntil)
+	}
+}
+
+func TestEmptyS3BucketKeepsVersioningUnderObjectLock(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	bucket := aws.String("locked")
+	retainUntil := time.Now().Add(24 * time.Hour).UTC()
+
+	r.GetBucketPolicy(gomock.Any()).Return(nil, awserr.New("NoSuchBucketPolicy", "", nil)).AnyTimes()
+	r.GetBucketVersioning(gomock.Any()).Return(&s3.GetBucketVersioningOutput{Status: aws.String(s3.BucketVersioningStatusEnabled)}, nil).AnyTimes()
+	r.PutBucketVersioning(gomock.Any()).Return(nil, awserr.New("InvalidBucketState", "An Object Lock configuration is present on this bucket, so the versioning state cannot be changed.", nil)).AnyTimes()
+	r.ListMultipartUploads(gomock.Any()).Return(&s3.ListMultipartUploadsOutput{}, nil).AnyTimes()
+	r.GetObjectLockConfiguration(gomock.Any()).Return(&s3.GetObjectLockConfigurationOutput{
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{ObjectLockEnabled: aws.String(s3.ObjectLockEnabledEnabled)},
+	}, nil).Times(1)
+	r.ListObjectVersions(gomock.Any()).Return(&s3.ListObjectVersionsOutput{Versions: []*s3.ObjectVersion{
+		{Key: aws.String("compliance"), VersionId: aws.String("1")},
+	}}, nil).Times(1)
+	r.PutObjectLegalHold(gomock.Any()).Return(&s3.PutObjectLegalHoldOutput{}, nil).Times(1)
+	r.GetObjectRetention(gomock.Any()).Return(&s3.GetObjectRetentionOutput{
+		Retention: &s3.ObjectLockRetention{Mode: aws.String(s3.ObjectLockRetentionModeCompliance), RetainUntilDate: aws.Time(retainUntil)},
+	}, nil).Times(1)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	err := EmptyS3Bucket(mocks.mockAWSClient, bucket, mocks.Logger)
+	var retentionErr *S3RetentionError
+	if !errors.As(err, &retentionErr) {
+		t.Fatalf("expected an S3RetentionError, got %v", err)
+	}
+	if !retentionErr.RetainUntil.Equal(retainUntil) {
+		t.Errorf("expected the bucket to be unshreddable until %v, got %v", retainUntil, retentionErr.RetainUntil)
+	}
+}
+
+func TestPrepareHostedZoneForDeletion(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	zone := &route53.HostedZone{Id: aws.String("zone"), Name: aws.String("example.com."), Config: &route53.HostedZoneConfig{PrivateZone: aws.Bool(true)}}
+	r.ListQueryLoggingConfigs(gomock.Any()).Return(&route53.ListQueryLoggingConfigsOutput{QueryLoggingConfigs: []*route53.QueryLoggingConfig{{Id: aws.String("log")}}}, nil).Times(1)
+	r.DeleteQueryLoggingConfig(&route53.DeleteQueryLoggingConfigInput{Id: aws.String("log")}).Return(&route53.DeleteQueryLoggingConfigOutput{}, nil).Times(1)
+	r.ListVPCAssociationAuthorizations(gomock.Any()).Return(&route53.ListVPCAssociationAuthorizationsOutput{}, nil).Times(1)
+	r.GetHostedZone(gomock.Any()).Return(&route53.GetHostedZoneOutput{VPCs: []*route53.VPC{{VPCId: aws.String("vpc-own")}, {VPCId: aws.String("vpc-other-account")}}}, nil).Times(1)
+	r.DisassociateVPCFromHostedZone(&route53.DisassociateVPCFromHostedZoneInput{HostedZoneId: aws.String("zone"), VPC: &route53.VPC{VPCId: aws.String("vpc-other-account")}}).Return(&route53.DisassociateVPCFromHostedZoneOutput{}, nil).Times(1)
+
+	if err := PrepareHostedZoneForDeletion(mocks.mockAWSClient, zone, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDisableHostedZoneDNSSEC(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	zoneID := aws.String("zone")
+	gomock.InOrder(
+		r.GetDNSSEC(&route53.GetDNSSECInput{HostedZoneId: zoneID}).Return(&route53.GetDNSSECOutput{
+			Status: &route53.DNSSECStatus{ServeSignature: aws.String("SIGNING")},
+			KeySigningKeys: []*route53.KeySigningKey{
+				{Name: aws.String("active"), Status: aws.String("ACTIVE")},
+				{Name: aws.String("inactive"), Status: aws.String("INACTIVE")},
+				{Name: aws.String("deleting"), Status: aws.String("DELETING")},
+			},
+		}, nil).Times(1),
+		r.DisableHostedZoneDNSSEC(&route53.DisableHostedZoneDNSSECInput{HostedZoneId: zoneID}).Return(&route53.DisableHostedZoneDNSSECOutput{}, nil).Times(1),
+		r.DeactivateKeySigningKey(&route53.DeactivateKeySigningKeyInput{HostedZoneId: zoneID, Name: aws.String("active")}).Return(&route53.DeactivateKeySigningKeyOutput{}, nil).Times(1),
+		r.DeleteKeySigningKey(&route53.DeleteKeySigningKeyInput{HostedZoneId: zoneID, Name: aws.String("active")}).Return(&route53.DeleteKeySigningKeyOutput{}, nil).Times(1),
+		r.DeleteKeySigningKey(&route53.DeleteKeySigningKeyInput{HostedZoneId: zoneID, Name: aws.String("inactive")}).Return(&route53.DeleteKeySigningKeyOutput{}, nil).Times(1),
+	)
+
+	if err := DisableHostedZoneDNSSEC(mocks.mockAWSClient, zoneID, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteHealthChecks(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	r.ListHealthChecks(gomock.Any()).Return(&route53.ListHealthChecksOutput{HealthChecks: []*route53.HealthCheck{
+		{Id: aws.String("own")},
+		{Id: aws.String("cloudmap"), LinkedService: &route53.LinkedService{ServicePrincipal: aws.String("servicediscovery.amazonaws.com")}},
+	}}, nil).Times(1)
+	r.DeleteHealthCheck(&route53.DeleteHealthCheckInput{HealthCheckId: aws.String("own")}).Return(&route53.DeleteHealthCheckOutput{}, nil).Times(1)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := DeleteHealthChecks(mocks.mockAWSClient, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestListResolverRulesForDeletion(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	r.ListResolverRules(gomock.Any()).Return(&route53resolver.ListResolverRulesOutput{ResolverRules: []*route53resolver.ResolverRule{
+		{Id: aws.String("rslvr-autodefined-rr-internet-resolver"), RuleType: aws.String("RECURSIVE")},
+		{Id: aws.String("rslvr-rr-shared"), RuleType: aws.String("FORWARD"), ShareStatus: aws.String("SHARED_WITH_ME")},
+		{Id: aws.String("rslvr-rr-own"), RuleType: aws.String("FORWARD"), ShareStatus: aws.String("NOT_SHARED")},
+	}}, nil).Times(1)
+
+	rules, err := ListResolverRulesForDeletion(mocks.mockAWSClient, mocks.Logger)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(aws.StringValueSlice(rules), []string{"rslvr-rr-own"}) {
+		t.Errorf("unexpected rules to be deleted: %v", aws.StringValueSlice(rules))
+	}
+}
+
+func TestCleanRoute53Resolver(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	gomock.InOrder(
+		r.ListResolverRuleAssociations(gomock.Any()).Return(&route53resolver.ListResolverRuleAssociationsOutput{ResolverRuleAssociations: []*route53resolver.ResolverRuleAssociation{
+			{Id: aws.String("rslvr-rrassoc-1"), ResolverRuleId: aws.String("rslvr-rr-own"), VPCId: aws.String("vpc")},
+		}}, nil),
+		r.DisassociateResolverRule(&route53resolver.DisassociateResolverRuleInput{ResolverRuleId: aws.String("rslvr-rr-own"), VPCId: aws.String("vpc")}).Return(&route53resolver.DisassociateResolverRuleOutput{}, nil),
+		r.ListResolverRules(gomock.Any()).Return(&route53resolver.ListResolverRulesOutput{ResolverRules: []*route53resolver.ResolverRule{{Id: aws.String("rslvr-rr-own")}}}, nil),
+		r.DeleteResolverRule(gomock.Any()).Return(&route53resolver.DeleteResolverRuleOutput{}, nil),
+		r.ListResolverQueryLogConfigAssociations(gomock.Any()).Return(&route53resolver.ListResolverQueryLogConfigAssociationsOutput{ResolverQueryLogConfigAssociations: []*route53resolver.ResolverQueryLogConfigAssociation{
+			{Id: aws.String("rqlca-1"), ResolverQueryLogConfigId: aws.String("rqlc-own"), ResourceId: aws.String("vpc")},
+		}}, nil),
+		r.DisassociateResolverQueryLogConfig(&route53resolver.DisassociateResolverQueryLogConfigInput{ResolverQueryLogConfigId: aws.String("rqlc-own"), ResourceId: aws.String("vpc")}).Return(&route53resolver.DisassociateResolverQueryLogConfigOutput{}, nil),
+		r.ListResolverQueryLogConfigs(gomock.Any()).Return(&route53resolver.ListResolverQueryLogConfigsOutput{ResolverQueryLogConfigs: []*route53resolver.ResolverQueryLogConfig{
+			{Id: aws.String("rqlc-shared"), ShareStatus: aws.String("SHARED_WITH_ME")},
+			{Id: aws.String("rqlc-own"), ShareStatus: aws.String("NOT_SHARED")},
+		}}, nil),
+		r.DeleteResolverQueryLogConfig(&route53resolver.DeleteResolverQueryLogConfigInput{ResolverQueryLogConfigId: aws.String("rqlc-own")}).Return(&route53resolver.DeleteResolverQueryLogConfigOutput{}, nil),
+		r.ListFirewallRuleGroupAssociations(gomock.Any()).Return(&route53resolver.ListFirewallRuleGroupAssociationsOutput{FirewallRuleGroupAssociations: []*route53resolver.FirewallRuleGroupAssociation{
+			{Id: aws.String("rslvr-frgassoc-fms"), FirewallRuleGroupId: aws.String("rslvr-frg-fms"), VpcId: aws.String("vpc"), ManagedOwnerName: aws.String("Route 53 Resolver DNS Firewall")},
+			{Id: aws.String("rslvr-frgassoc-own"), FirewallRuleGroupId: aws.String("rslvr-frg-own"), VpcId: aws.String("vpc"), MutationProtection: aws.String("ENABLED")},
+		}}, nil),
+		r.UpdateFirewallRuleGroupAssociation(&route53resolver.UpdateFirewallRuleGroupAssociationInput{FirewallRuleGroupAssociationId: aws.String("rslvr-frgassoc-own"), MutationProtection: aws.String("DISABLED")}).Return(&route53resolver.UpdateFirewallRuleGroupAssociationOutput{}, nil),
+		r.DisassociateFirewallRuleGroup(&route53resolver.DisassociateFirewallRuleGroupInput{FirewallRuleGroupAssociationId: aws.String("rslvr-frgassoc-own")}).Return(&route53resolver.DisassociateFirewallRuleGroupOutput{}, nil),
+		r.ListFirewallRuleGroups(gomock.Any()).Return(&route53resolver.ListFirewallRuleGroupsOutput{FirewallRuleGroups: []*route53resolver.FirewallRuleGroupMetadata{
+			{Id: aws.String("rslvr-frg-shared"), ShareStatus: aws.String("SHARED_WITH_ME")},
+			{Id: aws.String("rslvr-frg-own"), ShareStatus: aws.String("NOT_SHARED")},
+		}}, nil),
+		r.DeleteFirewallRuleGroup(&route53resolver.DeleteFirewallRuleGroupInput{FirewallRuleGroupId: aws.String("rslvr-frg-own")}).Return(&route53resolver.DeleteFirewallRuleGroupOutput{}, nil),
+		r.ListResolverEndpoints(gomock.Any()).Return(&route53resolver.ListResolverEndpointsOutput{ResolverEndpoints: []*route53resolver.ResolverEndpoint{{Id: aws.String("rslvr-out-1")}}}, nil),
+		r.DeleteResolverEndpoint(&route53resolver.DeleteResolverEndpointInput{ResolverEndpointId: aws.String("rslvr-out-1")}).Return(&route53resolver.DeleteResolverEndpointOutput{}, nil),
+	)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := CleanRoute53Resolver(mocks.mockAWSClient, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestBatchRecordSetDeletions(t *testing.T) {
+	createRecordSets := func(n int, value string) []*route53.ResourceRecordSet {
+		var records []*route53.ResourceRecordSet
+		for i := 0; i < n; i++ {
+			records = append(records, &route53.ResourceRecordSet{
+				Name:            aws.String(strconv.Itoa(i) + ".example.com."),
+				Type:            aws.String("TXT"),
+				ResourceRecords: []*route53.ResourceRecord{{Value: aws.String(value)}},
+			})
+		}
+		return records
+	}
+
+	testCases := []struct {
+		title         string
+		records       []*route53.ResourceRecordSet
+		expectedSizes []int
+	}{
+		{
+			title:         "test 1 - no records",
+			records:       nil,
+			expectedSizes: nil,
+		}, {
+			title:         "test 2 - more records than fit in one batch",
+			records:       createRecordSets(1500, "v"),
+			expectedSizes: []int{1000, 500},
+		}, {
+			title:         "test 3 - values too long for one batch",
+			records:       createRecordSets(5, strings.Repeat("v", 10000)),
+			expectedSizes: []int{3, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			var sizes []int
+			for _, batch := range batchRecordSetDeletions(tc.records) {
+				sizes = append(sizes, len(batch.Changes))
+			}
+			if !reflect.DeepEqual(sizes, tc.expectedSizes) {
+				t.Errorf("expected batches of %v, got %v", tc.expectedSizes, sizes)
+			}
+		})
+	}
+}
+
+func TestDeleteResourceRecordSetsFallsBackToSingleRecords(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	zone := &route53.HostedZone{Id: aws.String("zone"), Name: aws.String("example.com.")}
+	records := []*route53.ResourceRecordSet{
+		{Name: aws.String("good.example.com."), Type: aws.String("A"), ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("10.0.0.1")}}},
+		{Name: aws.String("bad.example.com."), Type: aws.String("A"), AliasTarget: &route53.AliasTarget{DNSName: aws.String("deleted.elb.amazonaws.com.")}},
+	}
+	gomock.InOrder(
+		r.ChangeResourceRecordSets(gomock.Any()).Return(nil, errors.New("InvalidChangeBatch")),
+		r.ChangeResourceRecordSets(gomock.Any()).Return(&route53.ChangeResourceRecordSetsOutput{}, nil),
+		r.ChangeResourceRecordSets(gomock.Any()).Return(nil, errors.New("InvalidChangeBatch")),
+	)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := DeleteResourceRecordSets(mocks.mockAWSClient, zone, records, mocks.Logger); err == nil {
+		t.Errorf("expected an error for the record that could not be deleted")
+	}
+}
+
+func TestDeleteCloudFrontDistributions(t *testing.T) {
+	testCases := []struct {
+		title         string
+		distribution  *cloudfront.DistributionSummary
+		setupAWSMock  func(r *mock.MockClientMockRecorder)
+		expectedError error
+	}{
+		{
+			title:        "test 1 - enabled distribution is disabled and left for a later pass",
+			distribution: &cloudfront.DistributionSummary{Id: aws.String("enabled"), Enabled: aws.Bool(true), Status: aws.String("Deployed")},
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.GetDistributionConfig(gomock.Any()).Return(&cloudfront.GetDistributionConfigOutput{ETag: aws.String("E1"), DistributionConfig: &cloudfront.DistributionConfig{Enabled: aws.Bool(true)}}, nil).Times(1)
+				r.UpdateDistribution(&cloudfront.UpdateDistributionInput{Id: aws.String("enabled"), IfMatch: aws.String("E1"), DistributionConfig: &cloudfront.DistributionConfig{Enabled: aws.Bool(false)}}).Return(&cloudfront.UpdateDistributionOutput{}, nil).Times(1)
+				r.DeleteDistribution(gomock.Any()).Times(0)
+			},
+			expectedError: ErrDeletionPending,
+		}, {
+			title:        "test 2 - disabled distribution still deploying is left for a later pass",
+			distribution: &cloudfront.DistributionSummary{Id: aws.String("deploying"), Enabled: aws.Bool(false), Status: aws.String("InProgress")},
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.GetDistributionConfig(gomock.Any()).Return(&cloudfront.GetDistributionConfigOutput{ETag: aws.String("E2"), DistributionConfig: &cloudfront.DistributionConfig{Enabled: aws.Bool(false)}}, nil).Times(1)
+				r.DeleteDistribution(gomock.Any()).Times(0)
+			},
+			expectedError: ErrDeletionPending,
+		}, {
+			title:        "test 3 - disabled and deployed distribution is deleted",
+			distribution: &cloudfront.DistributionSummary{Id: aws.String("disabled"), Enabled: aws.Bool(false), Status: aws.String("Deployed")},
+			setupAWSMock: func(r *mock.MockClientMockRecorder) {
+				r.GetDistributionConfig(gomock.Any()).Return(&cloudfront.GetDistributionConfigOutput{ETag: aws.String("E3"), DistributionConfig: &cloudfront.DistributionConfig{Enabled: aws.Bool(false)}}, nil).Times(1)
+				r.DeleteDistribution(&cloudfront.DeleteDistributionInput{Id: aws.String("disabled"), IfMatch: aws.String("E3")}).Return(&cloudfront.DeleteDistributionOutput{}, nil).Times(1)
+				r.GetRegion().Return("global").AnyTimes()
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			mocks := setupDefaultMocks(t)
+			tc.setupAWSMock(mocks.mockAWSClient.EXPECT())
+
+			err := DeleteCloudFrontDistributions(mocks.mockAWSClient, []*cloudfront.DistributionSummary{tc.distribution}, mocks.Logger)
+
+			if err != tc.expectedError {
+				t.Errorf("expected %v, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestDeleteAccelerators(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	accelerator := &globalaccelerator.Accelerator{AcceleratorArn: aws.String("accelerator"), Enabled: aws.Bool(false), Status: aws.String("DEPLOYED")}
+	gomock.InOrder(
+		r.ListListeners(gomock.Any()).Return(&globalaccelerator.ListListenersOutput{Listeners: []*globalaccelerator.Listener{{ListenerArn: aws.String("listener")}}}, nil),
+		r.ListEndpointGroups(gomock.Any()).Return(&globalaccelerator.ListEndpointGroupsOutput{EndpointGroups: []*globalaccelerator.EndpointGroup{{EndpointGroupArn: aws.String("group")}}}, nil),
+		r.DeleteEndpointGroup(&globalaccelerator.DeleteEndpointGroupInput{EndpointGroupArn: aws.String("group")}).Return(&globalaccelerator.DeleteEndpointGroupOutput{}, nil),
+		r.DeleteListener(&globalaccelerator.DeleteListenerInput{ListenerArn: aws.String("listener")}).Return(&globalaccelerator.DeleteListenerOutput{}, nil),
+		r.DeleteAccelerator(&globalaccelerator.DeleteAcceleratorInput{AcceleratorArn: aws.String("accelerator")}).Return(&globalaccelerator.DeleteAcceleratorOutput{}, nil),
+	)
+	r.GetRegion().Return("global").AnyTimes()
+
+	if err := DeleteAccelerators(mocks.mockAWSClient, []*globalaccelerator.Accelerator{accelerator}, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCleanWafv2(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+	gomock.InOrder(
+		r.ListWebACLs(gomock.Any()).Return(&wafv2.ListWebACLsOutput{WebACLs: []*wafv2.WebACLSummary{{Id: aws.String("acl"), Name: aws.String("acl"), LockToken: aws.String("token")}}}, nil),
+		r.DeleteWebACL(&wafv2.DeleteWebACLInput{Id: aws.String("acl"), Name: aws.String("acl"), LockToken: aws.String("token"), Scope: aws.String("REGIONAL")}).Return(&wafv2.DeleteWebACLOutput{}, nil),
+		r.ListIPSets(gomock.Any()).Return(&wafv2.ListIPSetsOutput{}, nil),
+	)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	if err := CleanWafv2(mocks.mockAWSClient, wafv2.ScopeRegional, mocks.Logger); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestWaitForVpcNatGatewaysDeleted(t *testing.T) {
+	defer func(interval time.Duration) { waiterPollInterval = interval }(waiterPollInterval)
+	waiterPollInterval = time.Millisecond
+
+	deleting := &ec2.DescribeNatGatewaysOutput{NatGateways: []*ec2.NatGateway{{NatGatewayId: aws.String("nat-1"), State: aws.String(ec2.NatGatewayStateDeleting)}}}
+
+	t.Run("deleted before the timeout", func(t *testing.T) {
+		mocks := setupDefaultMocks(t)
+		r := mocks.mockAWSClient.EXPECT()
+		gomock.InOrder(
+			r.DescribeNatGateways(gomock.Any()).Return(deleting, nil).Times(2),
+			r.DescribeNatGateways(gomock.Any()).Return(&ec2.DescribeNatGatewaysOutput{}, nil),
+		)
+
+		if err := WaitForVpcNatGatewaysDeleted(mocks.mockAWSClient, aws.String("vpc-1"), time.Second, mocks.Logger); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("still deleting after the timeout", func(t *testing.T) {
+		mocks := setupDefaultMocks(t)
+		mocks.mockAWSClient.EXPECT().DescribeNatGateways(gomock.Any()).Return(deleting, nil).MinTimes(1)
+
+		if err := WaitForVpcNatGatewaysDeleted(mocks.mockAWSClient, aws.String("vpc-1"), 5*time.Millisecond, mocks.Logger); err != ErrWaitTimeout {
+			t.Errorf("expected ErrWaitTimeout, got %v", err)
+		}
+	})
+}
+
+func TestWaitForVpcDependencies(t *testing.T) {
+	defer func(interval time.Duration) { waiterPollInterval = interval }(waiterPollInterval)
+	waiterPollInterval = time.Millisecond
+
+	t.Run("waits for every dependency", func(t *testing.T) {
+		mocks := setupDefaultMocks(t)
+		r := mocks.mockAWSClient.EXPECT()
+		gomock.InOrder(
+			r.DescribeInstances(gomock.Any()).Return(&ec2.DescribeInstancesOutput{}, nil),
+			r.DescribeNatGateways(gomock.Any()).Return(&ec2.DescribeNatGatewaysOutput{}, nil),
+			r.DescribeNetworkInterfaces(gomock.Any()).Return(&ec2.DescribeNetworkInterfacesOutput{}, nil),
+		)
+
+		if err := WaitForVpcDependencies(mocks.mockAWSClient, aws.String("vpc-1"), waitDeadline(time.Second), true, mocks.Logger); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("load balancers that failed to be deleted are not waited for", func(t *testing.T) {
+		mocks := setupDefaultMocks(t)
+		r := mocks.mockAWSClient.EXPECT()
+		gomock.InOrder(
+			r.DescribeInstances(gomock.Any()).Return(&ec2.DescribeInstancesOutput{}, nil),
+			r.DescribeNatGateways(gomock.Any()).Return(&ec2.DescribeNatGatewaysOutput{}, nil),
+		)
+
+		if err := WaitForVpcDependencies(mocks.mockAWSClient, aws.String("vpc-1"), waitDeadline(time.Second), false, mocks.Logger); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("deadline spent by the previous VPCs skips waiting", func(t *testing.T) {
+		mocks := setupDefaultMocks(t)
+
+		if err := WaitForVpcDependencies(mocks.mockAWSClient, aws.String("vpc-2"), time.Now().Add(-time.Second), true, mocks.Logger); err != ErrWaitTimeout {
+			t.Errorf("expected ErrWaitTimeout, got %v", err)
+		}
+	})
+
+	t.Run("zero deadline disables waiting", func(t *testing.T) {
+		mocks := setupDefaultMocks(t)
+
+		if err := WaitForVpcDependencies(mocks.mockAWSClient, aws.String("vpc-1"), waitDeadline(0), true, mocks.Logger); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("timeout bounds the whole wait", func(t *testing.T) {
+		mocks := setupDefaultMocks(t)
+		shuttingDown := &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{{InstanceId: aws.String("i-1")}}}}}
+		// the instances use up the whole timeout, so the NAT gateways and load balancers are not waited for
+		mocks.mockAWSClient.EXPECT().DescribeInstances(gomock.Any()).Return(shuttingDown, nil).MinTimes(1)
+
+		if err := WaitForVpcDependencies(mocks.mockAWSClient, aws.String("vpc-1"), waitDeadline(20*time.Millisecond), true, mocks.Logger); err != ErrWaitTimeout {
+			t.Errorf("expected ErrWaitTimeout, got %v", err)
+		}
+	})
+}
+
+func TestReleaseDedicatedHosts(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	r := mocks.mockAWSClient.EXPECT()
+
+	gomock.InOrder(
+		r.DescribeHosts(gomock.Any()).Return(&ec2.DescribeHostsOutput{
+			Hosts: []*ec2.Host{
+				{HostId: aws.String("h-available"), State: aws.String(ec2.AllocationStateAvailable)},
+				{HostId: aws.String("h-released"), State: aws.String(ec2.AllocationStateReleased)},
+				{HostId: aws.String("h-busy"), State: aws.String(ec2.AllocationStateAvailable)},
+			},
+		}, nil),
+		r.ReleaseHosts(&ec2.ReleaseHostsInput{HostIds: []*string{aws.String("h-available"), aws.String("h-busy")}}).Return(&ec2.ReleaseHostsOutput{
+			Successful: []*string{aws.String("h-available")},
+			Unsuccessful: []*ec2.UnsuccessfulItem{
+				{ResourceId: aws.String("h-busy"), Error: &ec2.UnsuccessfulItemError{Code: aws.String("Client.InvalidHost.HasInstances"), Message: aws.String("host has running instances")}},
+			},
+		}, nil),
+	)
+	r.GetRegion().Return("Region1").AnyTimes()
+
+	hostsToBeReleased, err := ListDedicatedHostsForDeletion(mocks.mockAWSClient, mocks.Logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = ReleaseDedicatedHosts(mocks.mockAWSClient, hostsToBeReleased, mocks.Logger); err == nil {
+		t.Error("expected an error for the host that could not be released")
+	}
+}
+
+func TestListCapacityReservationsForDeletion(t *testing.T) {
+	mocks := setupDefaultMocks(t)
+	mocks.mockAWSClient.EXPECT().DescribeCapacityReservations(gomock.Any()).Return(&ec2.DescribeCapacityReservationsOutput{
+		CapacityReservations: []*ec2.CapacityReservation{
+			{CapacityReservationId: aws.String("cr-active"), State: aws.String(ec2.CapacityReservationStateActive)},
+			{CapacityReservationId: aws.String("cr-expired"), State: aws.String(ec2.CapacityReservationStateExpired)},
+			{CapacityReservationId: aws.String("cr-pending"), State: aws.String(ec2.CapacityReservationStatePending)},
+			{CapacityReservationId: aws.String("cr-cancelled"), State: aws.String(ec2.CapacityReservationStateCancelled)},
+		},
+	}, nil)
+
+	reservations, err := ListCapacityReservationsForDeletion(mocks.mockAWSClient, mocks.Logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"cr-active", "cr-pending"}; !reflect.DeepEqual(aws.StringValueSlice(reservations), expected) {
+		t.Errorf("expected %v, got %v", expected, aws.StringValueSlice(reservations))
+	}
+}
@@ -0,0 +1,123 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ErrDeletionPending indicates resources have been disabled and can only be deleted on a later pass, once the change has deployed
+var ErrDeletionPending = errors.New("DeletionPending")
+
+// cloudFrontStatusDeployed is the status of a distribution whose last change has reached every edge location
+const cloudFrontStatusDeployed = "Deployed"
+
+// ListCloudFrontDistributionsForDeletion returns the summaries of every distribution of the account
+// the summaries carry the enabled flag and deployment status the deletion lifecycle depends on
+func ListCloudFrontDistributionsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*cloudfront.DistributionSummary, error) {
+
+	var distributionsToBeDeleted []*cloudfront.DistributionSummary
+	var marker *string
+	for {
+		distributionList, err := client.ListDistributions(&cloudfront.ListDistributionsInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list CloudFront distributions")
+			return nil, err
+		}
+		if distributionList.DistributionList == nil {
+			break
+		}
+
+		distributionsToBeDeleted = append(distributionsToBeDeleted, distributionList.DistributionList.Items...)
+
+		if aws.BoolValue(distributionList.DistributionList.IsTruncated) {
+			marker = distributionList.DistributionList.NextMarker
+		} else {
+			break
+		}
+	}
+	return distributionsToBeDeleted, nil
+}
+
+// DeleteCloudFrontDistributions takes each distribution one step further through its deletion lifecycle:
+// enabled distributions are disabled, disabled ones are deleted once the change has deployed
+// ErrDeletionPending is returned when nothing failed but distributions are left for a later pass
+func DeleteCloudFrontDistributions(client clientpkg.Client, distributionsToBeDeleted []*cloudfront.DistributionSummary, logger logr.Logger) error {
+
+	if distributionsToBeDeleted == nil {
+		return nil
+	}
+	var distributionsNotDeleted []*string
+	pending := false
+	for _, distribution := range distributionsToBeDeleted {
+		config, err := client.GetDistributionConfig(&cloudfront.GetDistributionConfigInput{Id: distribution.Id})
+		if err != nil {
+			logger.Error(err, "Failed to get CloudFront distribution config", "ID", *distribution.Id)
+			distributionsNotDeleted = append(distributionsNotDeleted, distribution.Id)
+			localMetrics.ResourceFail(localMetrics.CloudFrontDist, client.GetRegion())
+			continue
+		}
+
+		if aws.BoolValue(config.DistributionConfig.Enabled) {
+			config.DistributionConfig.Enabled = aws.Bool(false)
+			_, err = client.UpdateDistribution(&cloudfront.UpdateDistributionInput{Id: distribution.Id, IfMatch: config.ETag, DistributionConfig: config.DistributionConfig})
+			if err != nil {
+				logger.Error(err, "Failed to disable CloudFront distribution", "ID", *distribution.Id)
+				distributionsNotDeleted = append(distributionsNotDeleted, distribution.Id)
+				localMetrics.ResourceFail(localMetrics.CloudFrontDist, client.GetRegion())
+				continue
+			}
+			logger.Info("CloudFront distribution disabled, it is deleted once the change has deployed", "ID", *distribution.Id)
+			pending = true
+			continue
+		}
+
+		// disabling takes up to a few minutes to reach every edge location
+		if aws.StringValue(distribution.Status) != cloudFrontStatusDeployed {
+			logger.Info("CloudFront distribution is still being disabled", "ID", *distribution.Id)
+			pending = true
+			continue
+		}
+
+		_, err = client.DeleteDistribution(&cloudfront.DeleteDistributionInput{Id: distribution.Id, IfMatch: config.ETag})
+		if err != nil {
+			logger.Error(err, "Failed to delete CloudFront distribution", "ID", *distribution.Id)
+			distributionsNotDeleted = append(distributionsNotDeleted, distribution.Id)
+			localMetrics.ResourceFail(localMetrics.CloudFrontDist, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.CloudFrontDist, client.GetRegion())
+	}
+
+	if distributionsNotDeleted != nil {
+		return errors.New("FailedComprehensiveCloudFrontDistributionDeletion")
+	}
+	if pending {
+		return ErrDeletionPending
+	}
+	return nil
+}
+
+// CleanCloudFront disables and deletes CloudFront distributions, it runs in the global pass
+// a distribution can only be deleted after its disabling has deployed, so deletion usually completes on the next pass
+func CleanCloudFront(client clientpkg.Client, logger logr.Logger) error {
+	distributionsToBeDeleted, err := ListCloudFrontDistributionsForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+	err = DeleteCloudFrontDistributions(client, distributionsToBeDeleted, logger)
+	if err == ErrDeletionPending {
+		logger.Info("CloudFront distributions are being disabled and will be deleted on a later pass")
+		return err
+	}
+	if err != nil {
+		logger.Error(err, "Failed to delete CloudFront distributions")
+		return err
+	}
+	logger.Info("All CloudFront distributions have been deleted for this account")
+	return nil
+}
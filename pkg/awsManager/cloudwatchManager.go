@@ -0,0 +1,268 @@
+package awsManager
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+const (
+	// maxAlarmBatchSize is the most alarm names DeleteAlarms accepts in one call
+	maxAlarmBatchSize int = 100
+)
+
+// ListLogGroupsForDeletion returns the names of all log groups in the region, except those starting with one of the excluded prefixes
+func ListLogGroupsForDeletion(client clientpkg.Client, excludedPrefixes []string, logger logr.Logger) ([]*string, error) {
+
+	var logGroupsToBeDeleted []*string
+	var token *string
+	for {
+		logGroupList, err := client.DescribeLogGroups(&cloudwatchlogs.DescribeLogGroupsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list CloudWatch log groups")
+			return nil, err
+		}
+
+		for _, logGroup := range logGroupList.LogGroups {
+			if hasAnyPrefix(*logGroup.LogGroupName, excludedPrefixes) {
+				logger.Info("Skipping excluded log group", "Name", *logGroup.LogGroupName)
+				continue
+			}
+			logGroupsToBeDeleted = append(logGroupsToBeDeleted, logGroup.LogGroupName)
+		}
+
+		if logGroupList.NextToken != nil {
+			token = logGroupList.NextToken
+		} else {
+			break
+		}
+	}
+	return logGroupsToBeDeleted, nil
+}
+
+// DeleteLogGroups deletes the given log groups along with their log streams
+func DeleteLogGroups(client clientpkg.Client, logGroupsToBeDeleted []*string, logger logr.Logger) error {
+
+	if logGroupsToBeDeleted == nil {
+		return nil
+	}
+	var logGroupsNotDeleted []*string
+	for _, logGroupName := range logGroupsToBeDeleted {
+		_, err := client.DeleteLogGroup(&cloudwatchlogs.DeleteLogGroupInput{LogGroupName: logGroupName})
+		if err != nil {
+			logger.Error(err, "Failed to delete log group", "Name", *logGroupName)
+			logGroupsNotDeleted = append(logGroupsNotDeleted, logGroupName)
+			localMetrics.ResourceFail(localMetrics.CloudWatchLogGroup, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.CloudWatchLogGroup, client.GetRegion())
+	}
+
+	if logGroupsNotDeleted != nil {
+		return errors.New("FailedComprehensiveLogGroupDeletion")
+	}
+	return nil
+}
+
+// ListAlarmsForDeletion returns the names of all alarms of the given type (MetricAlarm or CompositeAlarm) in the region
+func ListAlarmsForDeletion(client clientpkg.Client, alarmType string, logger logr.Logger) ([]*string, error) {
+
+	var alarmsToBeDeleted []*string
+	var token *string
+	for {
+		alarmList, err := client.DescribeAlarms(&cloudwatch.DescribeAlarmsInput{AlarmTypes: aws.StringSlice([]string{alarmType}), NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list CloudWatch alarms", "AlarmType", alarmType)
+			return nil, err
+		}
+
+		for _, alarm := range alarmList.MetricAlarms {
+			alarmsToBeDeleted = append(alarmsToBeDeleted, alarm.AlarmName)
+		}
+		for _, alarm := range alarmList.CompositeAlarms {
+			alarmsToBeDeleted = append(alarmsToBeDeleted, alarm.AlarmName)
+		}
+
+		if alarmList.NextToken != nil {
+			token = alarmList.NextToken
+		} else {
+			break
+		}
+	}
+	return alarmsToBeDeleted, nil
+}
+
+// DeleteAlarms deletes the given alarms in batches
+func DeleteAlarms(client clientpkg.Client, alarmsToBeDeleted []*string, logger logr.Logger) error {
+
+	if alarmsToBeDeleted == nil {
+		return nil
+	}
+	errFlag := false
+	totalToDelete := len(alarmsToBeDeleted)
+	for lowerBound, upperBound := 0, 0; lowerBound <= totalToDelete-1; lowerBound = upperBound {
+		upperBound = lowerBound + maxAlarmBatchSize
+		if upperBound > totalToDelete {
+			upperBound = totalToDelete
+		}
+		batchedAlarms := alarmsToBeDeleted[lowerBound:upperBound]
+		_, err := client.DeleteAlarms(&cloudwatch.DeleteAlarmsInput{AlarmNames: batchedAlarms})
+		for range batchedAlarms {
+			if err != nil {
+				localMetrics.ResourceFail(localMetrics.CloudWatchAlarm, client.GetRegion())
+			} else {
+				localMetrics.ResourceSuccess(localMetrics.CloudWatchAlarm, client.GetRegion())
+			}
+		}
+		if err != nil {
+			logger.Error(err, "Failed to delete alarms", "Alarms", aws.StringValueSlice(batchedAlarms))
+			errFlag = true
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveAlarmDeletion")
+	}
+	return nil
+}
+
+// DeleteDashboards deletes every CloudWatch dashboard in the region
+func DeleteDashboards(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		dashboardList, err := client.ListDashboards(&cloudwatch.ListDashboardsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list CloudWatch dashboards")
+			return err
+		}
+
+		var dashboardNames []*string
+		for _, dashboard := range dashboardList.DashboardEntries {
+			dashboardNames = append(dashboardNames, dashboard.DashboardName)
+		}
+
+		if dashboardNames != nil {
+			_, err = client.DeleteDashboards(&cloudwatch.DeleteDashboardsInput{DashboardNames: dashboardNames})
+			for range dashboardNames {
+				if err != nil {
+					localMetrics.ResourceFail(localMetrics.CloudWatchDashboard, client.GetRegion())
+				} else {
+					localMetrics.ResourceSuccess(localMetrics.CloudWatchDashboard, client.GetRegion())
+				}
+			}
+			if err != nil {
+				logger.Error(err, "Failed to delete dashboards", "Dashboards", aws.StringValueSlice(dashboardNames))
+				errFlag = true
+			}
+		}
+
+		if dashboardList.NextToken != nil {
+			token = dashboardList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveDashboardDeletion")
+	}
+	return nil
+}
+
+// DeleteMetricStreams deletes every CloudWatch metric stream in the region
+func DeleteMetricStreams(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		metricStreamList, err := client.ListMetricStreams(&cloudwatch.ListMetricStreamsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list CloudWatch metric streams")
+			return err
+		}
+
+		for _, metricStream := range metricStreamList.Entries {
+			_, err = client.DeleteMetricStream(&cloudwatch.DeleteMetricStreamInput{Name: metricStream.Name})
+			if err != nil {
+				logger.Error(err, "Failed to delete metric stream", "Name", aws.StringValue(metricStream.Name))
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.MetricStream, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.MetricStream, client.GetRegion())
+		}
+
+		if metricStreamList.NextToken != nil {
+			token = metricStreamList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveMetricStreamDeletion")
+	}
+	return nil
+}
+
+// CleanCloudWatch removes log groups, alarms, dashboards and metric streams
+// log groups matching one of excludedLogGroupPrefixes are left in place
+// composite alarms are removed before the metric alarms their rules reference
+func CleanCloudWatch(client clientpkg.Client, excludedLogGroupPrefixes []string, logger logr.Logger) error {
+
+	errFlag := false
+
+	logGroupsToBeDeleted, err := ListLogGroupsForDeletion(client, excludedLogGroupPrefixes, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteLogGroups(client, logGroupsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete log groups")
+		errFlag = true
+	}
+
+	for _, alarmType := range []string{cloudwatch.AlarmTypeCompositeAlarm, cloudwatch.AlarmTypeMetricAlarm} {
+		alarmsToBeDeleted, err := ListAlarmsForDeletion(client, alarmType, logger)
+		if err != nil {
+			errFlag = true
+			continue
+		}
+		if err = DeleteAlarms(client, alarmsToBeDeleted, logger); err != nil {
+			logger.Error(err, "Failed to delete alarms", "AlarmType", alarmType)
+			errFlag = true
+		}
+	}
+
+	if err = DeleteDashboards(client, logger); err != nil {
+		logger.Error(err, "Failed to delete dashboards")
+		errFlag = true
+	}
+
+	if err = DeleteMetricStreams(client, logger); err != nil {
+		logger.Error(err, "Failed to delete metric streams")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanCloudWatch")
+	}
+	logger.Info("All CloudWatch log groups, alarms, dashboards and metric streams have been deleted for this region")
+	return nil
+}
+
+// hasAnyPrefix reports whether name starts with any of the given prefixes
+func hasAnyPrefix(name string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,346 @@
+package awsManager
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// defaultSecurityGroupName is the name of the security group AWS creates with every VPC
+const defaultSecurityGroupName = "default"
+
+// GetDefaultVpcID returns the ID of the default VPC of the region, or nil when the region has none
+func GetDefaultVpcID(client clientpkg.Client, logger logr.Logger) (*string, error) {
+
+	vpcList, err := client.DescribeVpcs(&ec2.DescribeVpcsInput{Filters: []*ec2.Filter{{Name: aws.String("isDefault"), Values: []*string{aws.String("true")}}}})
+	if err != nil {
+		logger.Error(err, "Failed to describe the default VPC")
+		return nil, err
+	}
+	for _, vpc := range vpcList.Vpcs {
+		if aws.BoolValue(vpc.IsDefault) {
+			return vpc.VpcId, nil
+		}
+	}
+	return nil, nil
+}
+
+// ResetDefaultVpc strips the default VPC back to the resources AWS creates with it
+// the default subnets, internet gateway, main route table, default network ACL and default security group are kept
+func ResetDefaultVpc(client clientpkg.Client, waitTimeout time.Duration, logger logr.Logger) error {
+
+	vpcID, err := GetDefaultVpcID(client, logger)
+	if err != nil {
+		return err
+	}
+	if vpcID == nil {
+		logger.Info("No default VPC to reset in this region")
+		return nil
+	}
+
+	errFlag := false
+	if err = DeleteVpcFlowLogs(client, vpcID, logger); err != nil {
+		errFlag = true
+	}
+	if err = DeleteVpcEndpoint(client, vpcID, logger); err != nil {
+		errFlag = true
+	}
+	loadBalancersDeleted := true
+	if err = DeleteELB(client, vpcID, logger); err != nil {
+		errFlag = true
+		loadBalancersDeleted = false
+	}
+	if err = DeleteNetworkLoadBalancer(client, vpcID, logger); err != nil {
+		errFlag = true
+		loadBalancersDeleted = false
+	}
+	if err = DeleteNatgateway(client, vpcID, logger); err != nil {
+		errFlag = true
+	}
+	// the deletions that follow report whatever is still in the way, so a failed wait does not fail the pass on its own
+	if err := WaitForVpcDependencies(client, vpcID, waitDeadline(waitTimeout), loadBalancersDeleted, logger); err != nil {
+		logger.Info("Carrying on without waiting for the remaining VPC dependencies", "VpcID", *vpcID, "Reason", err.Error())
+	}
+	if err = DeleteEgressOnlyInternetGateways(client, vpcID, logger); err != nil {
+		errFlag = true
+	}
+	if err = DeleteCarrierGateways(client, vpcID, logger); err != nil {
+		errFlag = true
+	}
+	if err = DetachAndDeleteNetworkInterface(client, vpcID, logger); err != nil {
+		errFlag = true
+	}
+	if err = deleteNonMainRouteTables(client, vpcID, logger); err != nil {
+		logger.Error(err, "Failed to delete route tables of the default VPC")
+		errFlag = true
+	}
+	if err = deleteNonDefaultNetworkAcls(client, vpcID, logger); err != nil {
+		logger.Error(err, "Failed to delete network ACLs of the default VPC")
+		errFlag = true
+	}
+	if err = deleteNonDefaultSubnets(client, vpcID, logger); err != nil {
+		logger.Error(err, "Failed to delete subnets of the default VPC")
+		errFlag = true
+	}
+	if err = DisassociateSecondaryVpcCidrBlocks(client, vpcID, logger); err != nil {
+		errFlag = true
+	}
+	if err = deleteNonDefaultSecurityGroups(client, vpcID, logger); err != nil {
+		logger.Error(err, "Failed to delete security groups of the default VPC")
+		errFlag = true
+	}
+	if err = restoreDefaultDhcpOptions(client, vpcID, logger); err != nil {
+		logger.Error(err, "Failed to restore the DHCP options of the default VPC")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToResetDefaultVpc")
+	}
+	logger.Info("Default VPC has been reset", "ID", *vpcID)
+	return nil
+}
+
+// RecreateDefaultVpc deletes the default VPC with everything in it and lets AWS create a new one
+// the new VPC is only requested once the old one is gone, otherwise the next pass tries again
+func RecreateDefaultVpc(client clientpkg.Client, waitTimeout time.Duration, logger logr.Logger) error {
+
+	vpcID, err := GetDefaultVpcID(client, logger)
+	if err != nil {
+		return err
+	}
+	if vpcID != nil {
+		err = DeleteVpcInstances(client, []*string{vpcID}, waitTimeout, logger)
+		if err != nil {
+			logger.Error(err, "Failed to delete the default VPC", "ID", *vpcID)
+			return err
+		}
+	}
+
+	output, err := client.CreateDefaultVpc(&ec2.CreateDefaultVpcInput{})
+	if err != nil {
+		logger.Error(err, "Failed to create the default VPC")
+		localMetrics.ResourceFail(localMetrics.DefaultVpc, client.GetRegion())
+		return err
+	}
+	localMetrics.ResourceSuccess(localMetrics.DefaultVpc, client.GetRegion())
+	logger.Info("Default VPC has been recreated", "ID", aws.StringValue(output.Vpc.VpcId))
+	return nil
+}
+
+// deleteNonMainRouteTables removes the subnet associations of the custom route tables of the VPC and deletes them
+// subnets fall back to the main route table once disassociated, which is left with its local and internet gateway routes
+func deleteNonMainRouteTables(client clientpkg.Client, vpcID *string, logger logr.Logger) error {
+
+	routeTableList, err := client.DescribeRouteTables(&ec2.DescribeRouteTablesInput{Filters: []*ec2.Filter{{Name: aws.String("vpc-id"), Values: []*string{vpcID}}}})
+	if err != nil {
+		logger.Error(err, "Failed to retrieve Route Table")
+		return err
+	}
+
+	errFlag := false
+	for _, routeTable := range routeTableList.RouteTables {
+		if isMainRouteTable(routeTable) {
+			// the default route to the internet gateway AWS created with the default VPC stays in place
+			if err = deleteCustomRoutes(client, routeTable, isInternetGatewayDefaultRoute, logger); err != nil {
+				errFlag = true
+			}
+			continue
+		}
+		for _, association := range routeTable.Associations {
+			_, err = client.DisassociateRouteTable(&ec2.DisassociateRouteTableInput{AssociationId: association.RouteTableAssociationId})
+			if err != nil {
+				logger.Error(err, "Failed to disassociate route-table", "ID", *routeTable.RouteTableId)
+			}
+		}
+		_, err = client.DeleteRouteTable(&ec2.DeleteRouteTableInput{RouteTableId: routeTable.RouteTableId})
+		if err != nil {
+			logger.Error(err, "Failed to delete route-table", "ID", *routeTable.RouteTableId)
+			errFlag = true
+			localMetrics.ResourceFail(localMetrics.RouteTable, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.RouteTable, client.GetRegion())
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveRouteTableDeletion")
+	}
+	return nil
+}
+
+// isInternetGatewayDefaultRoute reports whether the route is the IPv4 default route to an internet gateway
+func isInternetGatewayDefaultRoute(route *ec2.Route) bool {
+	return aws.StringValue(route.DestinationCidrBlock) == "0.0.0.0/0" && strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-")
+}
+
+// deleteNonDefaultNetworkAcls resets the default network ACL of the VPC, moves the subnets of the custom ACLs back to it and deletes them
+func deleteNonDefaultNetworkAcls(client clientpkg.Client, vpcID *string, logger logr.Logger) error {
+
+	aclList, err := client.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{Filters: []*ec2.Filter{{Name: aws.String("vpc-id"), Values: []*string{vpcID}}}})
+	if err != nil {
+		logger.Error(err, "Failed to retrieve ACL")
+		return err
+	}
+
+	errFlag := false
+	var defaultAclID *string
+	for _, acl := range aclList.NetworkAcls {
+		if aws.BoolValue(acl.IsDefault) {
+			defaultAclID = acl.NetworkAclId
+			if err = ResetDefaultNetworkAcl(client, acl, logger); err != nil {
+				errFlag = true
+			}
+		}
+	}
+
+	for _, acl := range aclList.NetworkAcls {
+		if aws.BoolValue(acl.IsDefault) {
+			continue
+		}
+		if defaultAclID != nil {
+			for _, association := range acl.Associations {
+				_, err = client.ReplaceNetworkAclAssociation(&ec2.ReplaceNetworkAclAssociationInput{AssociationId: association.NetworkAclAssociationId, NetworkAclId: defaultAclID})
+				if err != nil {
+					logger.Error(err, "Failed to move subnet back to the default ACL", "SubnetID", aws.StringValue(association.SubnetId))
+				}
+			}
+		}
+		_, err = client.DeleteNetworkAcl(&ec2.DeleteNetworkAclInput{NetworkAclId: acl.NetworkAclId})
+		if err != nil {
+			logger.Error(err, "Failed to delete ACL", "ID", *acl.NetworkAclId)
+			errFlag = true
+			localMetrics.ResourceFail(localMetrics.NetworkACL, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.NetworkACL, client.GetRegion())
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveNetworkAclDeletion")
+	}
+	return nil
+}
+
+// deleteNonDefaultSubnets deletes the subnets of the VPC that are not the default subnet of their availability zone
+func deleteNonDefaultSubnets(client clientpkg.Client, vpcID *string, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		subnetList, err := client.DescribeSubnets(&ec2.DescribeSubnetsInput{Filters: []*ec2.Filter{{Name: aws.String("vpc-id"), Values: []*string{vpcID}}}, NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to retrieve Subnet list")
+			return err
+		}
+
+		for _, subnet := range subnetList.Subnets {
+			if aws.BoolValue(subnet.DefaultForAz) {
+				continue
+			}
+			_, err := client.DeleteSubnet(&ec2.DeleteSubnetInput{SubnetId: subnet.SubnetId})
+			if err != nil {
+				logger.Error(err, "Failed to delete subnet-id", "ID", *subnet.SubnetId)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.Subnet, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.Subnet, client.GetRegion())
+		}
+
+		if subnetList.NextToken != nil {
+			token = subnetList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveSubnetDeletion")
+	}
+	return nil
+}
+
+// deleteNonDefaultSecurityGroups scrubs the custom rules of the default security group and deletes every other group of the VPC
+// all rules are revoked first so that groups referencing each other can be deleted in a single pass
+func deleteNonDefaultSecurityGroups(client clientpkg.Client, vpcID *string, logger logr.Logger) error {
+
+	securityGroupList, err := client.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{Filters: []*ec2.Filter{{Name: aws.String("vpc-id"), Values: []*string{vpcID}}}})
+	if err != nil {
+		logger.Error(err, "Failed to retrieve Security Group list")
+		return err
+	}
+
+	errFlag := false
+	var securityGroupsToBeDeleted []*ec2.SecurityGroup
+	for _, securityGroup := range securityGroupList.SecurityGroups {
+		if isDefaultSecurityGroup(securityGroup) {
+			if err = ScrubDefaultSecurityGroup(client, securityGroup, logger); err != nil {
+				errFlag = true
+			}
+			continue
+		}
+		securityGroupsToBeDeleted = append(securityGroupsToBeDeleted, securityGroup)
+	}
+
+	for _, securityGroup := range securityGroupsToBeDeleted {
+		if len(securityGroup.IpPermissions) > 0 {
+			_, err = client.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{IpPermissions: securityGroup.IpPermissions, GroupId: securityGroup.GroupId})
+			if err != nil {
+				logger.Error(err, "Failed to revoke ingress rules", "ID", *securityGroup.GroupId)
+			}
+		}
+		if len(securityGroup.IpPermissionsEgress) > 0 {
+			_, err = client.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{IpPermissions: securityGroup.IpPermissionsEgress, GroupId: securityGroup.GroupId})
+			if err != nil {
+				logger.Error(err, "Failed to revoke egress rules", "ID", *securityGroup.GroupId)
+			}
+		}
+	}
+
+	for _, securityGroup := range securityGroupsToBeDeleted {
+		_, err = client.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{GroupId: securityGroup.GroupId})
+		if err != nil {
+			logger.Error(err, "Failed to delete Security Group", "ID", *securityGroup.GroupId)
+			errFlag = true
+			localMetrics.ResourceFail(localMetrics.SecurityGroup, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.SecurityGroup, client.GetRegion())
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveSecurityGroupDeletion")
+	}
+	return nil
+}
+
+// restoreDefaultDhcpOptions associates the VPC with the DHCP option set AWS created for the region, if it still exists
+func restoreDefaultDhcpOptions(client clientpkg.Client, vpcID *string, logger logr.Logger) error {
+
+	dhcpOptionsList, err := client.DescribeDhcpOptions(&ec2.DescribeDhcpOptionsInput{})
+	if err != nil {
+		logger.Error(err, "Failed to list DHCP option sets")
+		return err
+	}
+
+	for _, dhcpOptions := range dhcpOptionsList.DhcpOptions {
+		if !isAwsDefaultDhcpOptions(dhcpOptions) {
+			continue
+		}
+		_, err = client.AssociateDhcpOptions(&ec2.AssociateDhcpOptionsInput{DhcpOptionsId: dhcpOptions.DhcpOptionsId, VpcId: vpcID})
+		if err != nil {
+			logger.Error(err, "Failed to associate the default DHCP option set", "ID", *dhcpOptions.DhcpOptionsId, "VpcID", *vpcID)
+			return err
+		}
+		return nil
+	}
+	logger.Info("No AWS created DHCP option set found, keeping the current one", "VpcID", *vpcID)
+	return nil
+}
@@ -0,0 +1,161 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListDynamoDBTablesForDeletion returns the names of all DynamoDB tables in the region
+func ListDynamoDBTablesForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var tablesToBeDeleted []*string
+	var lastTableName *string
+	for {
+		tableList, err := client.ListTables(&dynamodb.ListTablesInput{ExclusiveStartTableName: lastTableName})
+		if err != nil {
+			logger.Error(err, "Failed to list DynamoDB tables")
+			return nil, err
+		}
+
+		tablesToBeDeleted = append(tablesToBeDeleted, tableList.TableNames...)
+
+		if tableList.LastEvaluatedTableName != nil {
+			lastTableName = tableList.LastEvaluatedTableName
+		} else {
+			break
+		}
+	}
+	return tablesToBeDeleted, nil
+}
+
+// DisableTableDeletionProtection turns off the deletion protection of the table when it is on
+func DisableTableDeletionProtection(client clientpkg.Client, tableName *string, logger logr.Logger) error {
+
+	table, err := client.DescribeTable(&dynamodb.DescribeTableInput{TableName: tableName})
+	if err != nil {
+		logger.Error(err, "Failed to describe DynamoDB table", "Name", *tableName)
+		return err
+	}
+	if table.Table == nil || !aws.BoolValue(table.Table.DeletionProtectionEnabled) {
+		return nil
+	}
+
+	_, err = client.UpdateTable(&dynamodb.UpdateTableInput{TableName: tableName, DeletionProtectionEnabled: aws.Bool(false)})
+	if err != nil {
+		logger.Error(err, "Failed to disable deletion protection of DynamoDB table", "Name", *tableName)
+		return err
+	}
+	return nil
+}
+
+// DeleteDynamoDBTables deletes the given tables, turning off their deletion protection first
+func DeleteDynamoDBTables(client clientpkg.Client, tablesToBeDeleted []*string, logger logr.Logger) error {
+
+	if tablesToBeDeleted == nil {
+		return nil
+	}
+	var tablesNotDeleted []*string
+	for _, tableName := range tablesToBeDeleted {
+		if err := DisableTableDeletionProtection(client, tableName, logger); err != nil {
+			tablesNotDeleted = append(tablesNotDeleted, tableName)
+			localMetrics.ResourceFail(localMetrics.DynamoDBTable, client.GetRegion())
+			continue
+		}
+		_, err := client.DeleteTable(&dynamodb.DeleteTableInput{TableName: tableName})
+		if err != nil {
+			logger.Error(err, "Failed to delete DynamoDB table", "Name", *tableName)
+			tablesNotDeleted = append(tablesNotDeleted, tableName)
+			localMetrics.ResourceFail(localMetrics.DynamoDBTable, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.DynamoDBTable, client.GetRegion())
+	}
+
+	if tablesNotDeleted != nil {
+		return errors.New("FailedComprehensiveDynamoDBTableDeletion")
+	}
+	return nil
+}
+
+// ListDynamoDBBackupsForDeletion returns the ARNs of the on-demand backups in the region
+// backups taken by AWS Backup are owned by that service and have to be removed through it
+func ListDynamoDBBackupsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var backupsToBeDeleted []*string
+	var lastBackupArn *string
+	for {
+		backupList, err := client.ListBackups(&dynamodb.ListBackupsInput{BackupType: aws.String(dynamodb.BackupTypeFilterUser), ExclusiveStartBackupArn: lastBackupArn})
+		if err != nil {
+			logger.Error(err, "Failed to list DynamoDB backups")
+			return nil, err
+		}
+
+		for _, backup := range backupList.BackupSummaries {
+			backupsToBeDeleted = append(backupsToBeDeleted, backup.BackupArn)
+		}
+
+		if backupList.LastEvaluatedBackupArn != nil {
+			lastBackupArn = backupList.LastEvaluatedBackupArn
+		} else {
+			break
+		}
+	}
+	return backupsToBeDeleted, nil
+}
+
+// DeleteDynamoDBBackups deletes the given backups
+func DeleteDynamoDBBackups(client clientpkg.Client, backupsToBeDeleted []*string, logger logr.Logger) error {
+
+	if backupsToBeDeleted == nil {
+		return nil
+	}
+	var backupsNotDeleted []*string
+	for _, backupArn := range backupsToBeDeleted {
+		_, err := client.DeleteBackup(&dynamodb.DeleteBackupInput{BackupArn: backupArn})
+		if err != nil {
+			logger.Error(err, "Failed to delete DynamoDB backup", "ARN", *backupArn)
+			backupsNotDeleted = append(backupsNotDeleted, backupArn)
+			localMetrics.ResourceFail(localMetrics.DynamoDBBackup, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.DynamoDBBackup, client.GetRegion())
+	}
+
+	if backupsNotDeleted != nil {
+		return errors.New("FailedComprehensiveDynamoDBBackupDeletion")
+	}
+	return nil
+}
+
+// CleanDynamoDB lists and deletes DynamoDB tables and their on-demand backups
+func CleanDynamoDB(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+
+	tablesToBeDeleted, err := ListDynamoDBTablesForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteDynamoDBTables(client, tablesToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete DynamoDB tables")
+		errFlag = true
+	}
+
+	backupsToBeDeleted, err := ListDynamoDBBackupsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteDynamoDBBackups(client, backupsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete DynamoDB backups")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanDynamoDB")
+	}
+	logger.Info("All DynamoDB tables and backups have been deleted for this region")
+	return nil
+}
@@ -1,11 +1,13 @@
 package awsManager
 
 import (
+	"errors"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/go-logr/logr"
+	shredderConfig "github.com/openshift/aws-account-shredder/config"
 	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
 	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
 )
@@ -14,9 +16,51 @@ const (
 	maxBatchSize int = 50
 )
 
-// ListEc2InstancesForDeletion this lists all the instances that are eligible for deletion based on the tags and stored them in instances to be deleted
+// clusterTagKeys are the tags the installer and the account operator put on the instances of a cluster
+var clusterTagKeys = []string{"clusterAccountName", "clusterClaimLink", "clusterNamespace", "clusterClaimLinkNamespace"}
+
+// isEc2InstanceSelected tells whether the tags of an instance make it eligible for termination under the given selection
+// an instance carrying one of the excluded tags is never selected
+func isEc2InstanceSelected(instance *ec2.Instance, selection shredderConfig.Ec2Selection) bool {
+	for _, tag := range instance.Tags {
+		if value, ok := selection.ExcludeTags[aws.StringValue(tag.Key)]; ok && (value == "" || value == aws.StringValue(tag.Value)) {
+			return false
+		}
+	}
+
+	if selection.Mode == shredderConfig.Ec2SelectionModeAll {
+		return true
+	}
+	for _, tag := range instance.Tags {
+		key := aws.StringValue(tag.Key)
+		switch selection.Mode {
+		case shredderConfig.Ec2SelectionModeTagKey:
+			for _, prefix := range selection.TagKeys {
+				if strings.HasPrefix(key, prefix) {
+					return true
+				}
+			}
+		case shredderConfig.Ec2SelectionModeTagValue:
+			if selection.TagValueRegex.MatchString(aws.StringValue(tag.Value)) {
+				return true
+			}
+		default:
+			if strings.HasPrefix(key, "kubernetes.io") {
+				return true
+			}
+			for _, clusterTagKey := range clusterTagKeys {
+				if key == clusterTagKey {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// ListEc2InstancesForDeletion this lists all the instances that are eligible for deletion based on the selection and stored them in instances to be deleted
 // this only creates an array of pointers and does not delete the instances
-func ListEc2InstancesForDeletion(client clientpkg.Client, logger logr.Logger) []*string {
+func ListEc2InstancesForDeletion(client clientpkg.Client, selection shredderConfig.Ec2Selection, logger logr.Logger) ([]*string, error) {
 
 	var EC2InstancesToBeDeleted []*string
 	token := ""
@@ -24,23 +68,15 @@ func ListEc2InstancesForDeletion(client clientpkg.Client, logger logr.Logger) []
 		ec2Descriptions, err := client.DescribeInstances(&ec2.DescribeInstancesInput{NextToken: aws.String(token)})
 		if err != nil {
 			logger.Error(err, "Failed to retrieve EC2 descriptions")
+			return nil, err
 		}
 
 		// nested for loop to read the tags , as it is a part of structure inside a structure output. Refer : https://pkg.go.dev/github.com/aws/aws-sdk-go/service/ec2?tab=doc#DescribeInstancesOutput
 		for _, reservation := range ec2Descriptions.Reservations {
 			for _, instance := range reservation.Instances {
-				for _, tag := range instance.Tags {
-
-					// If an EC2 instance matches the following conditions, store it for deletion
-					if strings.HasPrefix(*tag.Key, "kubernetes.io") && (*instance.State.Code != 48) {
-						EC2InstancesToBeDeleted = append(EC2InstancesToBeDeleted, instance.InstanceId)
-						break
-					}
-
-					if (*tag.Key == "clusterAccountName" || *tag.Key == "clusterClaimLink" || *tag.Key == "clusterNamespace" || *tag.Key == "clusterClaimLinkNamespace") && (*instance.State.Code != 48) {
-						EC2InstancesToBeDeleted = append(EC2InstancesToBeDeleted, instance.InstanceId)
-						break
-					}
+				// If an EC2 instance is not terminated yet and matches the selection, store it for deletion
+				if *instance.State.Code != 48 && isEc2InstanceSelected(instance, selection) {
+					EC2InstancesToBeDeleted = append(EC2InstancesToBeDeleted, instance.InstanceId)
 				}
 			}
 		}
@@ -53,44 +89,223 @@ func ListEc2InstancesForDeletion(client clientpkg.Client, logger logr.Logger) []
 		}
 	}
 
-	return EC2InstancesToBeDeleted
+	return EC2InstancesToBeDeleted, nil
+}
+
+// DisableInstanceProtection clears the DisableApiTermination and DisableApiStop attributes of the instance
+// ModifyInstanceAttribute only changes one attribute per call, so each takes its own call
+// only the termination protection error is returned, stop protection does not block termination
+// and spot or instance store backed instances reject the change, so clearing it is best effort
+func DisableInstanceProtection(client clientpkg.Client, instanceID *string, logger logr.Logger) error {
+	_, err := client.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+		InstanceId:            instanceID,
+		DisableApiTermination: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+	})
+	if err != nil {
+		logger.Error(err, "Failed to disable termination protection", "InstanceID", *instanceID)
+		return err
+	}
+
+	_, err = client.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+		InstanceId:     instanceID,
+		DisableApiStop: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+	})
+	if err != nil {
+		logger.Info("Failed to disable stop protection, terminating the instance anyway", "InstanceID", *instanceID, "Reason", err.Error())
+	}
+	return nil
+}
+
+// terminateEc2InstancesOneByOne terminates each instance on its own
+// it is the fallback for a failed batch, so one instance that can not be terminated does not hold back the others
+func terminateEc2InstancesOneByOne(client clientpkg.Client, instanceIDs []*string, logger logr.Logger) []*string {
+	var instancesNotDeleted []*string
+	for _, instanceID := range instanceIDs {
+		_, err := client.TerminateInstances(&ec2.TerminateInstancesInput{InstanceIds: []*string{instanceID}})
+		if err != nil {
+			logger.Error(err, "Failed to delete instance", "InstanceID", *instanceID)
+			instancesNotDeleted = append(instancesNotDeleted, instanceID)
+			localMetrics.ResourceFail(localMetrics.Ec2Instance, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.Ec2Instance, client.GetRegion())
+	}
+	return instancesNotDeleted
 }
 
 // DeleteEc2Instance deletes all ec2 instances in the given list
+// the protection of every instance is cleared first, as a single protected instance makes its whole batch fail
+// instances are then terminated in batches, a failed batch is retried instance by instance
+// instances whose protection could not be cleared are terminated on their own, so they fail with the actual reason
 func DeleteEc2Instance(client clientpkg.Client, EC2InstancesToBeDeleted []*string, logger logr.Logger) error {
 	if EC2InstancesToBeDeleted == nil {
 		return nil
 	}
 
+	var instancesToTerminate []*string
+	var instancesStillProtected []*string
+	for _, instanceID := range EC2InstancesToBeDeleted {
+		if err := DisableInstanceProtection(client, instanceID, logger); err != nil {
+			instancesStillProtected = append(instancesStillProtected, instanceID)
+			continue
+		}
+		instancesToTerminate = append(instancesToTerminate, instanceID)
+	}
+
+	instancesNotDeleted := terminateEc2InstancesOneByOne(client, instancesStillProtected, logger)
+
 	// We're batching the deletes to avoid hitting the limit of instances per request.
 	// This uses a sliding window to iterate through the list in batches.
-	totalToDelete := len(EC2InstancesToBeDeleted)
+	totalToDelete := len(instancesToTerminate)
 	for lowerBound, upperBound := 0, 0; lowerBound <= totalToDelete-1; lowerBound = upperBound {
 		upperBound = lowerBound + maxBatchSize
 		if upperBound > totalToDelete {
 			upperBound = totalToDelete
 		}
-		batchedEC2Instances := EC2InstancesToBeDeleted[lowerBound:upperBound]
+		batchedEC2Instances := instancesToTerminate[lowerBound:upperBound]
 		_, err := client.TerminateInstances(&ec2.TerminateInstancesInput{InstanceIds: batchedEC2Instances})
 		if err != nil {
-			localMetrics.ResourceFail(localMetrics.Ec2Instance, client.GetRegion())
-			logger.Error(err, "Failed to delete instances provided", "Instances", &batchedEC2Instances)
+			logger.Error(err, "Failed to delete instances provided, retrying one by one", "Instances", &batchedEC2Instances)
+			instancesNotDeleted = append(instancesNotDeleted, terminateEc2InstancesOneByOne(client, batchedEC2Instances, logger)...)
+			continue
+		}
+		for range batchedEC2Instances {
+			localMetrics.ResourceSuccess(localMetrics.Ec2Instance, client.GetRegion())
+		}
+	}
+
+	if instancesNotDeleted != nil {
+		return errors.New("FailedComprehensiveEc2InstanceDeletion")
+	}
+	return nil
+}
+
+// CancelSpotFleetRequests cancels the spot fleet requests of the region, terminating their instances along with them when terminateInstances is set
+// a fleet that is not cancelled keeps replacing the instances terminated by the cleaner
+func CancelSpotFleetRequests(client clientpkg.Client, terminateInstances bool, logger logr.Logger) error {
+
+	var fleetsToBeCancelled []*string
+	var token *string
+	for {
+		fleetList, err := client.DescribeSpotFleetRequests(&ec2.DescribeSpotFleetRequestsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to describe spot fleet requests")
 			return err
 		}
+
+		for _, fleet := range fleetList.SpotFleetRequestConfigs {
+			switch aws.StringValue(fleet.SpotFleetRequestState) {
+			case ec2.BatchStateCancelled, ec2.BatchStateCancelledRunning, ec2.BatchStateCancelledTerminating, ec2.BatchStateFailed:
+				continue
+			}
+			fleetsToBeCancelled = append(fleetsToBeCancelled, fleet.SpotFleetRequestId)
+		}
+
+		if fleetList.NextToken != nil {
+			token = fleetList.NextToken
+		} else {
+			break
+		}
+	}
+	if fleetsToBeCancelled == nil {
+		return nil
+	}
+
+	output, err := client.CancelSpotFleetRequests(&ec2.CancelSpotFleetRequestsInput{SpotFleetRequestIds: fleetsToBeCancelled, TerminateInstances: aws.Bool(terminateInstances)})
+	if err != nil {
+		logger.Error(err, "Failed to cancel spot fleet requests", "FleetIDs", fleetsToBeCancelled)
+		for range fleetsToBeCancelled {
+			localMetrics.ResourceFail(localMetrics.SpotFleetRequest, client.GetRegion())
+		}
+		return err
+	}
+	for range output.SuccessfulFleetRequests {
+		localMetrics.ResourceSuccess(localMetrics.SpotFleetRequest, client.GetRegion())
+	}
+	for _, failure := range output.UnsuccessfulFleetRequests {
+		logger.Error(errors.New(aws.StringValue(failure.Error.Message)), "Failed to cancel spot fleet request", "FleetID", aws.StringValue(failure.SpotFleetRequestId))
+		localMetrics.ResourceFail(localMetrics.SpotFleetRequest, client.GetRegion())
+	}
+	if output.UnsuccessfulFleetRequests != nil {
+		return errors.New("FailedComprehensiveSpotFleetRequestCancellation")
 	}
-	localMetrics.ResourceSuccess(localMetrics.Ec2Instance, client.GetRegion())
 	return nil
 }
 
-// CleanEc2Instances lists and deletes eligible ec2 instances
-func CleanEc2Instances(client clientpkg.Client, logger logr.Logger) error {
-	eC2InstancesToBeDeleted := ListEc2InstancesForDeletion(client, logger)
-	err := DeleteEc2Instance(client, eC2InstancesToBeDeleted, logger)
+// CancelSpotInstanceRequests cancels the open and active spot instance requests of the region
+// a persistent request that is not cancelled launches a new instance for every one the cleaner terminates
+func CancelSpotInstanceRequests(client clientpkg.Client, logger logr.Logger) error {
+
+	var requestsToBeCancelled []*string
+	var token *string
+	for {
+		requestList, err := client.DescribeSpotInstanceRequests(&ec2.DescribeSpotInstanceRequestsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to describe spot instance requests")
+			return err
+		}
+
+		for _, request := range requestList.SpotInstanceRequests {
+			switch aws.StringValue(request.State) {
+			case ec2.SpotInstanceStateCancelled, ec2.SpotInstanceStateClosed, ec2.SpotInstanceStateFailed:
+				continue
+			}
+			requestsToBeCancelled = append(requestsToBeCancelled, request.SpotInstanceRequestId)
+		}
+
+		if requestList.NextToken != nil {
+			token = requestList.NextToken
+		} else {
+			break
+		}
+	}
+	if requestsToBeCancelled == nil {
+		return nil
+	}
+
+	// cancelling a request leaves its instance running, it is terminated with the other instances
+	_, err := client.CancelSpotInstanceRequests(&ec2.CancelSpotInstanceRequestsInput{SpotInstanceRequestIds: requestsToBeCancelled})
 	if err != nil {
-		logger.Error(err, "Failed to delete ec2 instances")
+		logger.Error(err, "Failed to cancel spot instance requests", "RequestIDs", requestsToBeCancelled)
+		for range requestsToBeCancelled {
+			localMetrics.ResourceFail(localMetrics.SpotRequest, client.GetRegion())
+		}
 		return err
 	}
-	logger.Info("All EC2 instances have been terminated for this region")
+	for range requestsToBeCancelled {
+		localMetrics.ResourceSuccess(localMetrics.SpotRequest, client.GetRegion())
+	}
 	return nil
+}
+
+// CleanEc2Instances cancels spot fleets and spot instance requests, then lists and deletes the ec2 instances matching the selection
+// fleet instances are only terminated with their fleet when every instance is selected, otherwise the selection decides for them too
+func CleanEc2Instances(client clientpkg.Client, selection shredderConfig.Ec2Selection, logger logr.Logger) error {
 
+	errFlag := false
+
+	if err := CancelSpotFleetRequests(client, selection.Mode == shredderConfig.Ec2SelectionModeAll && len(selection.ExcludeTags) == 0, logger); err != nil {
+		errFlag = true
+	}
+
+	if err := CancelSpotInstanceRequests(client, logger); err != nil {
+		errFlag = true
+	}
+
+	eC2InstancesToBeDeleted, err := ListEc2InstancesForDeletion(client, selection, logger)
+	if err != nil {
+		errFlag = true
+	} else {
+		logger.Info("EC2 instances selected for termination", "Selection", selection.String(), "Instances", aws.StringValueSlice(eC2InstancesToBeDeleted))
+		if err = DeleteEc2Instance(client, eC2InstancesToBeDeleted, logger); err != nil {
+			logger.Error(err, "Failed to delete ec2 instances")
+			errFlag = true
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanEc2Instances")
+	}
+	logger.Info("All EC2 instances have been terminated for this region")
+	return nil
 }
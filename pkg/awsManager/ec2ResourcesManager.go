@@ -0,0 +1,251 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListKeyPairsForDeletion returns the names of the EC2 key pairs in the region
+func ListKeyPairsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	keyPairList, err := client.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{})
+	if err != nil {
+		logger.Error(err, "Failed to describe key pairs")
+		return nil, err
+	}
+
+	var keyPairsToBeDeleted []*string
+	for _, keyPair := range keyPairList.KeyPairs {
+		keyPairsToBeDeleted = append(keyPairsToBeDeleted, keyPair.KeyName)
+	}
+	return keyPairsToBeDeleted, nil
+}
+
+// DeleteKeyPairs deletes the given key pairs, instances launched with them are not affected
+func DeleteKeyPairs(client clientpkg.Client, keyPairsToBeDeleted []*string, logger logr.Logger) error {
+
+	if keyPairsToBeDeleted == nil {
+		return nil
+	}
+	var keyPairsNotDeleted []*string
+	for _, keyName := range keyPairsToBeDeleted {
+		_, err := client.DeleteKeyPair(&ec2.DeleteKeyPairInput{KeyName: keyName})
+		if err != nil {
+			logger.Error(err, "Failed to delete key pair", "Name", *keyName)
+			keyPairsNotDeleted = append(keyPairsNotDeleted, keyName)
+			localMetrics.ResourceFail(localMetrics.KeyPair, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.KeyPair, client.GetRegion())
+	}
+
+	if keyPairsNotDeleted != nil {
+		return errors.New("FailedComprehensiveKeyPairDeletion")
+	}
+	return nil
+}
+
+// ListPlacementGroupsForDeletion returns the names of the placement groups in the region that are not being deleted already
+func ListPlacementGroupsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	placementGroupList, err := client.DescribePlacementGroups(&ec2.DescribePlacementGroupsInput{})
+	if err != nil {
+		logger.Error(err, "Failed to describe placement groups")
+		return nil, err
+	}
+
+	var placementGroupsToBeDeleted []*string
+	for _, placementGroup := range placementGroupList.PlacementGroups {
+		switch aws.StringValue(placementGroup.State) {
+		case ec2.PlacementGroupStateDeleting, ec2.PlacementGroupStateDeleted:
+			continue
+		}
+		placementGroupsToBeDeleted = append(placementGroupsToBeDeleted, placementGroup.GroupName)
+	}
+	return placementGroupsToBeDeleted, nil
+}
+
+// DeletePlacementGroups deletes the given placement groups
+// a placement group can only be deleted once the instances in it are terminated
+func DeletePlacementGroups(client clientpkg.Client, placementGroupsToBeDeleted []*string, logger logr.Logger) error {
+
+	if placementGroupsToBeDeleted == nil {
+		return nil
+	}
+	var placementGroupsNotDeleted []*string
+	for _, groupName := range placementGroupsToBeDeleted {
+		_, err := client.DeletePlacementGroup(&ec2.DeletePlacementGroupInput{GroupName: groupName})
+		if err != nil {
+			logger.Error(err, "Failed to delete placement group", "Name", *groupName)
+			placementGroupsNotDeleted = append(placementGroupsNotDeleted, groupName)
+			localMetrics.ResourceFail(localMetrics.PlacementGroup, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.PlacementGroup, client.GetRegion())
+	}
+
+	if placementGroupsNotDeleted != nil {
+		return errors.New("FailedComprehensivePlacementGroupDeletion")
+	}
+	return nil
+}
+
+// ListDedicatedHostsForDeletion returns the IDs of the dedicated hosts of the region that are still allocated
+func ListDedicatedHostsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var hostsToBeReleased []*string
+	var token *string
+	for {
+		hostList, err := client.DescribeHosts(&ec2.DescribeHostsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to describe dedicated hosts")
+			return nil, err
+		}
+
+		for _, host := range hostList.Hosts {
+			switch aws.StringValue(host.State) {
+			case ec2.AllocationStateReleased, ec2.AllocationStateReleasedPermanentFailure:
+				continue
+			}
+			hostsToBeReleased = append(hostsToBeReleased, host.HostId)
+		}
+
+		if hostList.NextToken != nil {
+			token = hostList.NextToken
+		} else {
+			break
+		}
+	}
+	return hostsToBeReleased, nil
+}
+
+// ReleaseDedicatedHosts releases the given dedicated hosts, which are billed by the hour for as long as they are allocated
+// a host can only be released once the instances running on it are terminated
+func ReleaseDedicatedHosts(client clientpkg.Client, hostsToBeReleased []*string, logger logr.Logger) error {
+
+	if hostsToBeReleased == nil {
+		return nil
+	}
+	output, err := client.ReleaseHosts(&ec2.ReleaseHostsInput{HostIds: hostsToBeReleased})
+	if err != nil {
+		logger.Error(err, "Failed to release dedicated hosts", "HostIDs", aws.StringValueSlice(hostsToBeReleased))
+		for range hostsToBeReleased {
+			localMetrics.ResourceFail(localMetrics.DedicatedHost, client.GetRegion())
+		}
+		return err
+	}
+	for range output.Successful {
+		localMetrics.ResourceSuccess(localMetrics.DedicatedHost, client.GetRegion())
+	}
+	for _, failure := range output.Unsuccessful {
+		logger.Error(errors.New(aws.StringValue(failure.Error.Message)), "Failed to release dedicated host", "HostID", aws.StringValue(failure.ResourceId))
+		localMetrics.ResourceFail(localMetrics.DedicatedHost, client.GetRegion())
+	}
+
+	if output.Unsuccessful != nil {
+		return errors.New("FailedComprehensiveDedicatedHostRelease")
+	}
+	return nil
+}
+
+// ListCapacityReservationsForDeletion returns the IDs of the pending and active capacity reservations of the region
+func ListCapacityReservationsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var reservationsToBeCancelled []*string
+	var token *string
+	for {
+		reservationList, err := client.DescribeCapacityReservations(&ec2.DescribeCapacityReservationsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to describe capacity reservations")
+			return nil, err
+		}
+
+		for _, reservation := range reservationList.CapacityReservations {
+			switch aws.StringValue(reservation.State) {
+			case ec2.CapacityReservationStateActive, ec2.CapacityReservationStatePending:
+				reservationsToBeCancelled = append(reservationsToBeCancelled, reservation.CapacityReservationId)
+			}
+		}
+
+		if reservationList.NextToken != nil {
+			token = reservationList.NextToken
+		} else {
+			break
+		}
+	}
+	return reservationsToBeCancelled, nil
+}
+
+// CancelCapacityReservations cancels the given capacity reservations, which are billed whether instances use them or not
+func CancelCapacityReservations(client clientpkg.Client, reservationsToBeCancelled []*string, logger logr.Logger) error {
+
+	if reservationsToBeCancelled == nil {
+		return nil
+	}
+	var reservationsNotCancelled []*string
+	for _, reservationID := range reservationsToBeCancelled {
+		_, err := client.CancelCapacityReservation(&ec2.CancelCapacityReservationInput{CapacityReservationId: reservationID})
+		if err != nil {
+			logger.Error(err, "Failed to cancel capacity reservation", "ID", *reservationID)
+			reservationsNotCancelled = append(reservationsNotCancelled, reservationID)
+			localMetrics.ResourceFail(localMetrics.CapacityReservation, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.CapacityReservation, client.GetRegion())
+	}
+
+	if reservationsNotCancelled != nil {
+		return errors.New("FailedComprehensiveCapacityReservationCancellation")
+	}
+	return nil
+}
+
+// CleanEc2Resources deletes key pairs and placement groups, releases dedicated hosts and cancels capacity reservations
+// placement groups and dedicated hosts are only freed once their instances are terminated, so this runs after the VPCs are torn down
+func CleanEc2Resources(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+
+	keyPairsToBeDeleted, err := ListKeyPairsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteKeyPairs(client, keyPairsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete key pairs")
+		errFlag = true
+	}
+
+	placementGroupsToBeDeleted, err := ListPlacementGroupsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeletePlacementGroups(client, placementGroupsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete placement groups")
+		errFlag = true
+	}
+
+	hostsToBeReleased, err := ListDedicatedHostsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = ReleaseDedicatedHosts(client, hostsToBeReleased, logger); err != nil {
+		logger.Error(err, "Failed to release dedicated hosts")
+		errFlag = true
+	}
+
+	reservationsToBeCancelled, err := ListCapacityReservationsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = CancelCapacityReservations(client, reservationsToBeCancelled, logger); err != nil {
+		logger.Error(err, "Failed to cancel capacity reservations")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanEc2Resources")
+	}
+	logger.Info("All key pairs, placement groups, dedicated hosts and capacity reservations have been removed for this region")
+	return nil
+}
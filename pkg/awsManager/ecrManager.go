@@ -0,0 +1,75 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListEcrRepositoriesForDeletion returns the names of all ECR repositories in the region
+func ListEcrRepositoriesForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var repositoriesToBeDeleted []*string
+	var token *string
+	for {
+		repositoryList, err := client.DescribeRepositories(&ecr.DescribeRepositoriesInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list ECR repositories")
+			return nil, err
+		}
+
+		for _, repository := range repositoryList.Repositories {
+			repositoriesToBeDeleted = append(repositoriesToBeDeleted, repository.RepositoryName)
+		}
+
+		if repositoryList.NextToken != nil {
+			token = repositoryList.NextToken
+		} else {
+			break
+		}
+	}
+	return repositoriesToBeDeleted, nil
+}
+
+// DeleteEcrRepositories force deletes the given repositories, removing any images they still contain
+func DeleteEcrRepositories(client clientpkg.Client, repositoriesToBeDeleted []*string, logger logr.Logger) error {
+
+	if repositoriesToBeDeleted == nil {
+		return nil
+	}
+	var repositoriesNotDeleted []*string
+	for _, repositoryName := range repositoriesToBeDeleted {
+		_, err := client.DeleteRepository(&ecr.DeleteRepositoryInput{RepositoryName: repositoryName, Force: aws.Bool(true)})
+		if err != nil {
+			logger.Error(err, "Failed to delete ECR repository", "Name", *repositoryName)
+			repositoriesNotDeleted = append(repositoriesNotDeleted, repositoryName)
+			localMetrics.ResourceFail(localMetrics.EcrRepository, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.EcrRepository, client.GetRegion())
+	}
+
+	if repositoriesNotDeleted != nil {
+		return errors.New("FailedComprehensiveEcrRepositoryDeletion")
+	}
+	return nil
+}
+
+// CleanEcrRepositories lists and deletes ECR repositories
+func CleanEcrRepositories(client clientpkg.Client, logger logr.Logger) error {
+	repositoriesToBeDeleted, err := ListEcrRepositoriesForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+	err = DeleteEcrRepositories(client, repositoriesToBeDeleted, logger)
+	if err != nil {
+		logger.Error(err, "Failed to delete ECR repositories")
+		return err
+	}
+	logger.Info("All ECR repositories have been deleted for this region")
+	return nil
+}
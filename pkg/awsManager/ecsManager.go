@@ -0,0 +1,246 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListEcsClustersForDeletion returns the ARNs of all ECS clusters in the region
+func ListEcsClustersForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var clustersToBeDeleted []*string
+	var token *string
+	for {
+		clusterList, err := client.ListClustersECS(&ecs.ListClustersInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list ECS clusters")
+			return nil, err
+		}
+
+		clustersToBeDeleted = append(clustersToBeDeleted, clusterList.ClusterArns...)
+
+		if clusterList.NextToken != nil {
+			token = clusterList.NextToken
+		} else {
+			break
+		}
+	}
+	return clustersToBeDeleted, nil
+}
+
+// DeleteEcsServices scales every service in the cluster to zero and deletes it
+// daemon services can not be scaled, those are force deleted instead
+func DeleteEcsServices(client clientpkg.Client, clusterArn *string, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		serviceList, err := client.ListServices(&ecs.ListServicesInput{Cluster: clusterArn, NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list ECS services", "Cluster", *clusterArn)
+			return err
+		}
+
+		for _, serviceArn := range serviceList.ServiceArns {
+			_, scaleErr := client.UpdateService(&ecs.UpdateServiceInput{Cluster: clusterArn, Service: serviceArn, DesiredCount: aws.Int64(0)})
+			if scaleErr != nil {
+				logger.Info("Unable to scale ECS service to zero, force deleting it", "Service", *serviceArn)
+			}
+
+			_, err := client.DeleteService(&ecs.DeleteServiceInput{Cluster: clusterArn, Service: serviceArn, Force: aws.Bool(scaleErr != nil)})
+			if err != nil {
+				logger.Error(err, "Failed to delete ECS service", "Service", *serviceArn)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.EcsService, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.EcsService, client.GetRegion())
+		}
+
+		if serviceList.NextToken != nil {
+			token = serviceList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveEcsServiceDeletion")
+	}
+	return nil
+}
+
+// StopEcsTasks stops the tasks still running in the cluster, including standalone tasks not owned by a service
+func StopEcsTasks(client clientpkg.Client, clusterArn *string, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		taskList, err := client.ListTasks(&ecs.ListTasksInput{Cluster: clusterArn, NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list ECS tasks", "Cluster", *clusterArn)
+			return err
+		}
+
+		for _, taskArn := range taskList.TaskArns {
+			_, err := client.StopTask(&ecs.StopTaskInput{Cluster: clusterArn, Task: taskArn, Reason: aws.String("Stopped by aws-account-shredder")})
+			if err != nil {
+				logger.Error(err, "Failed to stop ECS task", "Task", *taskArn)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.EcsTask, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.EcsTask, client.GetRegion())
+		}
+
+		if taskList.NextToken != nil {
+			token = taskList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveEcsTaskStop")
+	}
+	return nil
+}
+
+// DeregisterEcsContainerInstances deregisters every container instance from the cluster
+// the backing EC2 instances are left to the EC2 cleaner
+func DeregisterEcsContainerInstances(client clientpkg.Client, clusterArn *string, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		containerInstanceList, err := client.ListContainerInstances(&ecs.ListContainerInstancesInput{Cluster: clusterArn, NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list ECS container instances", "Cluster", *clusterArn)
+			return err
+		}
+
+		for _, containerInstanceArn := range containerInstanceList.ContainerInstanceArns {
+			_, err := client.DeregisterContainerInstance(&ecs.DeregisterContainerInstanceInput{Cluster: clusterArn, ContainerInstance: containerInstanceArn, Force: aws.Bool(true)})
+			if err != nil {
+				logger.Error(err, "Failed to deregister ECS container instance", "ContainerInstance", *containerInstanceArn)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.EcsContainer, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.EcsContainer, client.GetRegion())
+		}
+
+		if containerInstanceList.NextToken != nil {
+			token = containerInstanceList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveEcsContainerInstanceDeregistration")
+	}
+	return nil
+}
+
+// DeleteEcsClusters drains and deletes the given clusters
+// services take a while to drain, so a cluster that still reports them is retried on the next pass
+func DeleteEcsClusters(client clientpkg.Client, clustersToBeDeleted []*string, logger logr.Logger) error {
+
+	if clustersToBeDeleted == nil {
+		return nil
+	}
+	var clustersNotDeleted []*string
+	for _, clusterArn := range clustersToBeDeleted {
+
+		if err := DeleteEcsServices(client, clusterArn, logger); err != nil {
+			logger.Error(err, "Failed to delete ECS services", "Cluster", *clusterArn)
+		}
+		if err := StopEcsTasks(client, clusterArn, logger); err != nil {
+			logger.Error(err, "Failed to stop ECS tasks", "Cluster", *clusterArn)
+		}
+		if err := DeregisterEcsContainerInstances(client, clusterArn, logger); err != nil {
+			logger.Error(err, "Failed to deregister ECS container instances", "Cluster", *clusterArn)
+		}
+
+		_, err := client.DeleteClusterECS(&ecs.DeleteClusterInput{Cluster: clusterArn})
+		if err != nil {
+			logger.Error(err, "Failed to delete ECS cluster", "Cluster", *clusterArn)
+			clustersNotDeleted = append(clustersNotDeleted, clusterArn)
+			localMetrics.ResourceFail(localMetrics.EcsCluster, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.EcsCluster, client.GetRegion())
+	}
+
+	if clustersNotDeleted != nil {
+		return errors.New("FailedComprehensiveEcsClusterDeletion")
+	}
+	return nil
+}
+
+// DeregisterEcsTaskDefinitions deregisters all active task definitions in the region
+// deregistered revisions are kept by AWS as INACTIVE and can not be removed any further
+func DeregisterEcsTaskDefinitions(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		taskDefinitionList, err := client.ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{Status: aws.String(ecs.TaskDefinitionStatusActive), NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list ECS task definitions")
+			return err
+		}
+
+		for _, taskDefinitionArn := range taskDefinitionList.TaskDefinitionArns {
+			_, err := client.DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{TaskDefinition: taskDefinitionArn})
+			if err != nil {
+				logger.Error(err, "Failed to deregister ECS task definition", "TaskDefinition", *taskDefinitionArn)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.EcsTaskDefinition, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.EcsTaskDefinition, client.GetRegion())
+		}
+
+		if taskDefinitionList.NextToken != nil {
+			token = taskDefinitionList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveEcsTaskDefinitionDeregistration")
+	}
+	return nil
+}
+
+// CleanEcs removes ECS clusters with their services, tasks and container instances, then the task definitions
+func CleanEcs(client clientpkg.Client, logger logr.Logger) error {
+	clustersToBeDeleted, err := ListEcsClustersForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+
+	errFlag := false
+	if err = DeleteEcsClusters(client, clustersToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete ECS clusters")
+		errFlag = true
+	}
+	if err = DeregisterEcsTaskDefinitions(client, logger); err != nil {
+		logger.Error(err, "Failed to deregister ECS task definitions")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanEcs")
+	}
+	logger.Info("All ECS resources have been deleted for this region")
+	return nil
+}
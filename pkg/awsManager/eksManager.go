@@ -0,0 +1,156 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListEksClustersForDeletion returns the names of all EKS clusters in the region
+func ListEksClustersForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var clustersToBeDeleted []*string
+	var token *string
+	for {
+		clusterList, err := client.ListClustersEKS(&eks.ListClustersInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list EKS clusters")
+			return nil, err
+		}
+
+		clustersToBeDeleted = append(clustersToBeDeleted, clusterList.Clusters...)
+
+		if clusterList.NextToken != nil {
+			token = clusterList.NextToken
+		} else {
+			break
+		}
+	}
+	return clustersToBeDeleted, nil
+}
+
+// DeleteEksNodegroups requests deletion of every managed nodegroup of the cluster and returns how many were found
+func DeleteEksNodegroups(client clientpkg.Client, clusterName *string, logger logr.Logger) (int, error) {
+
+	found := 0
+	var token *string
+	for {
+		nodegroupList, err := client.ListNodegroups(&eks.ListNodegroupsInput{ClusterName: clusterName, NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list EKS nodegroups", "Cluster", *clusterName)
+			return found, err
+		}
+
+		for _, nodegroupName := range nodegroupList.Nodegroups {
+			found++
+			_, err := client.DeleteNodegroup(&eks.DeleteNodegroupInput{ClusterName: clusterName, NodegroupName: nodegroupName})
+			if err != nil {
+				// a nodegroup already being deleted returns ResourceInUseException, which is expected between passes
+				logger.Error(err, "Failed to delete EKS nodegroup", "Cluster", *clusterName, "Nodegroup", *nodegroupName)
+				localMetrics.ResourceFail(localMetrics.EksNodegroup, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.EksNodegroup, client.GetRegion())
+		}
+
+		if nodegroupList.NextToken != nil {
+			token = nodegroupList.NextToken
+		} else {
+			break
+		}
+	}
+	return found, nil
+}
+
+// DeleteEksFargateProfiles requests deletion of every Fargate profile of the cluster and returns how many were found
+// AWS only lets one profile per cluster be deleting at a time, so the rest are picked up on later passes
+func DeleteEksFargateProfiles(client clientpkg.Client, clusterName *string, logger logr.Logger) (int, error) {
+
+	found := 0
+	var token *string
+	for {
+		profileList, err := client.ListFargateProfiles(&eks.ListFargateProfilesInput{ClusterName: clusterName, NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list EKS Fargate profiles", "Cluster", *clusterName)
+			return found, err
+		}
+
+		for _, profileName := range profileList.FargateProfileNames {
+			found++
+			_, err := client.DeleteFargateProfile(&eks.DeleteFargateProfileInput{ClusterName: clusterName, FargateProfileName: profileName})
+			if err != nil {
+				logger.Error(err, "Failed to delete EKS Fargate profile", "Cluster", *clusterName, "FargateProfile", *profileName)
+				localMetrics.ResourceFail(localMetrics.EksFargateProfile, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.EksFargateProfile, client.GetRegion())
+		}
+
+		if profileList.NextToken != nil {
+			token = profileList.NextToken
+		} else {
+			break
+		}
+	}
+	return found, nil
+}
+
+// DeleteEksClusters deletes the nodegroups and Fargate profiles of the given clusters
+// the cluster itself is only deleted once none of those are left, which usually takes more than one pass
+func DeleteEksClusters(client clientpkg.Client, clustersToBeDeleted []*string, logger logr.Logger) error {
+
+	if clustersToBeDeleted == nil {
+		return nil
+	}
+	errFlag := false
+	for _, clusterName := range clustersToBeDeleted {
+
+		nodegroups, err := DeleteEksNodegroups(client, clusterName, logger)
+		if err != nil {
+			errFlag = true
+			continue
+		}
+		profiles, err := DeleteEksFargateProfiles(client, clusterName, logger)
+		if err != nil {
+			errFlag = true
+			continue
+		}
+		if nodegroups > 0 || profiles > 0 {
+			logger.Info("EKS cluster still has nodegroups or Fargate profiles, deferring cluster deletion", "Cluster", *clusterName)
+			errFlag = true
+			continue
+		}
+
+		_, err = client.DeleteClusterEKS(&eks.DeleteClusterInput{Name: clusterName})
+		if err != nil {
+			logger.Error(err, "Failed to delete EKS cluster", "Cluster", *clusterName)
+			errFlag = true
+			localMetrics.ResourceFail(localMetrics.EksCluster, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.EksCluster, client.GetRegion())
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveEksClusterDeletion")
+	}
+	return nil
+}
+
+// CleanEks lists and deletes EKS clusters along with their nodegroups and Fargate profiles
+func CleanEks(client clientpkg.Client, logger logr.Logger) error {
+	clustersToBeDeleted, err := ListEksClustersForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+	err = DeleteEksClusters(client, clustersToBeDeleted, logger)
+	if err != nil {
+		logger.Error(err, "Failed to delete EKS clusters")
+		return err
+	}
+	logger.Info("All EKS clusters have been deleted for this region")
+	return nil
+}
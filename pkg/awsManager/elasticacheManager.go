@@ -0,0 +1,266 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// defaultCacheSubnetGroupName is created by AWS and can not be deleted
+const defaultCacheSubnetGroupName = "default"
+
+// cacheStatusDeleting is reported by clusters and replication groups that are already being removed
+const cacheStatusDeleting = "deleting"
+
+// ListCacheReplicationGroupsForDeletion returns the IDs of the replication groups in the region that are not already being deleted
+func ListCacheReplicationGroupsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var groupsToBeDeleted []*string
+	var marker *string
+	for {
+		groupList, err := client.DescribeReplicationGroups(&elasticache.DescribeReplicationGroupsInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list ElastiCache replication groups")
+			return nil, err
+		}
+
+		for _, group := range groupList.ReplicationGroups {
+			if aws.StringValue(group.Status) != cacheStatusDeleting {
+				groupsToBeDeleted = append(groupsToBeDeleted, group.ReplicationGroupId)
+			}
+		}
+
+		if groupList.Marker != nil {
+			marker = groupList.Marker
+		} else {
+			break
+		}
+	}
+	return groupsToBeDeleted, nil
+}
+
+// DeleteCacheReplicationGroups deletes the given replication groups along with all of their member clusters
+func DeleteCacheReplicationGroups(client clientpkg.Client, groupsToBeDeleted []*string, logger logr.Logger) error {
+
+	if groupsToBeDeleted == nil {
+		return nil
+	}
+	var groupsNotDeleted []*string
+	for _, groupID := range groupsToBeDeleted {
+		_, err := client.DeleteReplicationGroup(&elasticache.DeleteReplicationGroupInput{ReplicationGroupId: groupID, RetainPrimaryCluster: aws.Bool(false)})
+		if err != nil {
+			logger.Error(err, "Failed to delete ElastiCache replication group", "ID", *groupID)
+			groupsNotDeleted = append(groupsNotDeleted, groupID)
+			localMetrics.ResourceFail(localMetrics.CacheReplGroup, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.CacheReplGroup, client.GetRegion())
+	}
+
+	if groupsNotDeleted != nil {
+		return errors.New("FailedComprehensiveCacheReplicationGroupDeletion")
+	}
+	return nil
+}
+
+// ListCacheClustersForDeletion returns the IDs of the standalone cache clusters in the region that are not already being deleted
+// clusters that belong to a replication group are removed together with the group
+func ListCacheClustersForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var clustersToBeDeleted []*string
+	var marker *string
+	for {
+		clusterList, err := client.DescribeCacheClusters(&elasticache.DescribeCacheClustersInput{ShowCacheClustersNotInReplicationGroups: aws.Bool(true), Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list ElastiCache clusters")
+			return nil, err
+		}
+
+		for _, cluster := range clusterList.CacheClusters {
+			if aws.StringValue(cluster.CacheClusterStatus) != cacheStatusDeleting {
+				clustersToBeDeleted = append(clustersToBeDeleted, cluster.CacheClusterId)
+			}
+		}
+
+		if clusterList.Marker != nil {
+			marker = clusterList.Marker
+		} else {
+			break
+		}
+	}
+	return clustersToBeDeleted, nil
+}
+
+// DeleteCacheClusters deletes the given cache clusters without taking a final snapshot
+func DeleteCacheClusters(client clientpkg.Client, clustersToBeDeleted []*string, logger logr.Logger) error {
+
+	if clustersToBeDeleted == nil {
+		return nil
+	}
+	var clustersNotDeleted []*string
+	for _, clusterID := range clustersToBeDeleted {
+		_, err := client.DeleteCacheCluster(&elasticache.DeleteCacheClusterInput{CacheClusterId: clusterID})
+		if err != nil {
+			logger.Error(err, "Failed to delete ElastiCache cluster", "ID", *clusterID)
+			clustersNotDeleted = append(clustersNotDeleted, clusterID)
+			localMetrics.ResourceFail(localMetrics.CacheCluster, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.CacheCluster, client.GetRegion())
+	}
+
+	if clustersNotDeleted != nil {
+		return errors.New("FailedComprehensiveCacheClusterDeletion")
+	}
+	return nil
+}
+
+// ListCacheSnapshotsForDeletion returns the names of the manual ElastiCache snapshots in the region
+// automatic snapshots are removed by AWS along with their cluster
+func ListCacheSnapshotsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var snapshotsToBeDeleted []*string
+	var marker *string
+	for {
+		snapshotList, err := client.DescribeSnapshotsElastiCache(&elasticache.DescribeSnapshotsInput{SnapshotSource: aws.String("user"), Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list ElastiCache snapshots")
+			return nil, err
+		}
+
+		for _, snapshot := range snapshotList.Snapshots {
+			snapshotsToBeDeleted = append(snapshotsToBeDeleted, snapshot.SnapshotName)
+		}
+
+		if snapshotList.Marker != nil {
+			marker = snapshotList.Marker
+		} else {
+			break
+		}
+	}
+	return snapshotsToBeDeleted, nil
+}
+
+// DeleteCacheSnapshots deletes the given snapshots
+func DeleteCacheSnapshots(client clientpkg.Client, snapshotsToBeDeleted []*string, logger logr.Logger) error {
+
+	if snapshotsToBeDeleted == nil {
+		return nil
+	}
+	var snapshotsNotDeleted []*string
+	for _, snapshotName := range snapshotsToBeDeleted {
+		_, err := client.DeleteSnapshotElastiCache(&elasticache.DeleteSnapshotInput{SnapshotName: snapshotName})
+		if err != nil {
+			logger.Error(err, "Failed to delete ElastiCache snapshot", "Name", *snapshotName)
+			snapshotsNotDeleted = append(snapshotsNotDeleted, snapshotName)
+			localMetrics.ResourceFail(localMetrics.CacheSnapshot, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.CacheSnapshot, client.GetRegion())
+	}
+
+	if snapshotsNotDeleted != nil {
+		return errors.New("FailedComprehensiveCacheSnapshotDeletion")
+	}
+	return nil
+}
+
+// ListCacheSubnetGroupsForDeletion returns the names of the cache subnet groups in the region, except for the default one
+func ListCacheSubnetGroupsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var subnetGroupsToBeDeleted []*string
+	var marker *string
+	for {
+		subnetGroupList, err := client.DescribeCacheSubnetGroups(&elasticache.DescribeCacheSubnetGroupsInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list ElastiCache subnet groups")
+			return nil, err
+		}
+
+		for _, subnetGroup := range subnetGroupList.CacheSubnetGroups {
+			if aws.StringValue(subnetGroup.CacheSubnetGroupName) != defaultCacheSubnetGroupName {
+				subnetGroupsToBeDeleted = append(subnetGroupsToBeDeleted, subnetGroup.CacheSubnetGroupName)
+			}
+		}
+
+		if subnetGroupList.Marker != nil {
+			marker = subnetGroupList.Marker
+		} else {
+			break
+		}
+	}
+	return subnetGroupsToBeDeleted, nil
+}
+
+// DeleteCacheSubnetGroups deletes the given subnet groups
+// a subnet group still used by a cluster that is being deleted fails, and is picked up again on the next pass
+func DeleteCacheSubnetGroups(client clientpkg.Client, subnetGroupsToBeDeleted []*string, logger logr.Logger) error {
+
+	if subnetGroupsToBeDeleted == nil {
+		return nil
+	}
+	var subnetGroupsNotDeleted []*string
+	for _, subnetGroupName := range subnetGroupsToBeDeleted {
+		_, err := client.DeleteCacheSubnetGroup(&elasticache.DeleteCacheSubnetGroupInput{CacheSubnetGroupName: subnetGroupName})
+		if err != nil {
+			logger.Error(err, "Failed to delete ElastiCache subnet group", "Name", *subnetGroupName)
+			subnetGroupsNotDeleted = append(subnetGroupsNotDeleted, subnetGroupName)
+			localMetrics.ResourceFail(localMetrics.CacheSubnetGroup, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.CacheSubnetGroup, client.GetRegion())
+	}
+
+	if subnetGroupsNotDeleted != nil {
+		return errors.New("FailedComprehensiveCacheSubnetGroupDeletion")
+	}
+	return nil
+}
+
+// CleanElastiCache deletes replication groups, standalone clusters, manual snapshots and subnet groups
+func CleanElastiCache(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+
+	groupsToBeDeleted, err := ListCacheReplicationGroupsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteCacheReplicationGroups(client, groupsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete ElastiCache replication groups")
+		errFlag = true
+	}
+
+	clustersToBeDeleted, err := ListCacheClustersForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteCacheClusters(client, clustersToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete ElastiCache clusters")
+		errFlag = true
+	}
+
+	snapshotsToBeDeleted, err := ListCacheSnapshotsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteCacheSnapshots(client, snapshotsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete ElastiCache snapshots")
+		errFlag = true
+	}
+
+	subnetGroupsToBeDeleted, err := ListCacheSubnetGroupsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteCacheSubnetGroups(client, subnetGroupsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete ElastiCache subnet groups")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanElastiCache")
+	}
+	logger.Info("All ElastiCache resources have been deleted for this region")
+	return nil
+}
@@ -0,0 +1,67 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListElasticsearchDomainsForDeletion returns the names of the OpenSearch (Elasticsearch) domains in the region
+func ListElasticsearchDomainsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	// does not require pagination, every domain is returned at once
+	domainList, err := client.ListDomainNames(&elasticsearchservice.ListDomainNamesInput{})
+	if err != nil {
+		logger.Error(err, "Failed to list OpenSearch domains")
+		return nil, err
+	}
+
+	var domainsToBeDeleted []*string
+	for _, domain := range domainList.DomainNames {
+		domainsToBeDeleted = append(domainsToBeDeleted, domain.DomainName)
+	}
+	return domainsToBeDeleted, nil
+}
+
+// DeleteElasticsearchDomains deletes the given domains
+// manual snapshots live in a customer owned S3 bucket and are removed with it
+func DeleteElasticsearchDomains(client clientpkg.Client, domainsToBeDeleted []*string, logger logr.Logger) error {
+
+	if domainsToBeDeleted == nil {
+		return nil
+	}
+	var domainsNotDeleted []*string
+	for _, domainName := range domainsToBeDeleted {
+		_, err := client.DeleteElasticsearchDomain(&elasticsearchservice.DeleteElasticsearchDomainInput{DomainName: domainName})
+		if err != nil {
+			logger.Error(err, "Failed to delete OpenSearch domain", "Name", *domainName)
+			domainsNotDeleted = append(domainsNotDeleted, domainName)
+			localMetrics.ResourceFail(localMetrics.EsDomain, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.EsDomain, client.GetRegion())
+	}
+
+	if domainsNotDeleted != nil {
+		return errors.New("FailedComprehensiveElasticsearchDomainDeletion")
+	}
+	return nil
+}
+
+// CleanElasticsearch lists and deletes OpenSearch (Elasticsearch) domains
+func CleanElasticsearch(client clientpkg.Client, logger logr.Logger) error {
+	domainsToBeDeleted, err := ListElasticsearchDomainsForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+	err = DeleteElasticsearchDomains(client, domainsToBeDeleted, logger)
+	if err != nil {
+		logger.Error(err, "Failed to delete OpenSearch domains")
+		return err
+	}
+	logger.Info("All OpenSearch domains have been deleted for this region")
+	return nil
+}
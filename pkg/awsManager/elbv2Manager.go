@@ -0,0 +1,212 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// gatewayLoadBalancerEndpointType is the VPC endpoint type used to reach a Gateway Load Balancer
+// the pinned SDK predates Gateway Load Balancers and has no constant for it
+const gatewayLoadBalancerEndpointType = "GatewayLoadBalancer"
+
+// ListLoadBalancersV2ForDeletion returns the ARNs of every application, network and gateway load balancer in the region
+func ListLoadBalancersV2ForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var loadBalancersToBeDeleted []*string
+	var marker *string
+	for {
+		loadBalancerList, err := client.DescribeLoadBalancers2(&elbv2.DescribeLoadBalancersInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list load balancers")
+			return nil, err
+		}
+
+		for _, loadBalancer := range loadBalancerList.LoadBalancers {
+			loadBalancersToBeDeleted = append(loadBalancersToBeDeleted, loadBalancer.LoadBalancerArn)
+		}
+
+		if loadBalancerList.NextMarker != nil {
+			marker = loadBalancerList.NextMarker
+		} else {
+			break
+		}
+	}
+	return loadBalancersToBeDeleted, nil
+}
+
+// DeleteLoadBalancersV2 deletes the given load balancers, their listeners and rules go with them
+func DeleteLoadBalancersV2(client clientpkg.Client, loadBalancersToBeDeleted []*string, logger logr.Logger) error {
+
+	if loadBalancersToBeDeleted == nil {
+		return nil
+	}
+	var loadBalancersNotDeleted []*string
+	for _, loadBalancerArn := range loadBalancersToBeDeleted {
+		err := deleteLoadBalancerV2(client, loadBalancerArn, logger)
+		if err != nil {
+			loadBalancersNotDeleted = append(loadBalancersNotDeleted, loadBalancerArn)
+		}
+	}
+
+	if loadBalancersNotDeleted != nil {
+		return errors.New("FailedComprehensiveLoadBalancerDeletion")
+	}
+	return nil
+}
+
+// deleteLoadBalancerV2 turns off deletion protection and deletes a single load balancer
+func deleteLoadBalancerV2(client clientpkg.Client, loadBalancerArn *string, logger logr.Logger) error {
+
+	// load balancers with deletion protection can not be deleted, switching it off is harmless when it is already off
+	_, err := client.ModifyLoadBalancerAttributes2(&elbv2.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: loadBalancerArn,
+		Attributes:      []*elbv2.LoadBalancerAttribute{{Key: aws.String("deletion_protection.enabled"), Value: aws.String("false")}},
+	})
+	if err != nil {
+		logger.Error(err, "Failed to disable load balancer deletion protection", "ARN", *loadBalancerArn)
+	}
+
+	_, err = client.DeleteLoadBalancer2(&elbv2.DeleteLoadBalancerInput{LoadBalancerArn: loadBalancerArn})
+	if err != nil {
+		logger.Error(err, "Failed to delete load balancer", "ARN", *loadBalancerArn)
+		localMetrics.ResourceFail(localMetrics.NetworkLoadBalancer, client.GetRegion())
+		return err
+	}
+	localMetrics.ResourceSuccess(localMetrics.NetworkLoadBalancer, client.GetRegion())
+	return nil
+}
+
+// DeleteGatewayLoadBalancerEndpoints deletes the VPC endpoints pointing at a Gateway Load Balancer
+// the load balancer can not be deleted while its endpoint service still has endpoints attached
+func DeleteGatewayLoadBalancerEndpoints(client clientpkg.Client, logger logr.Logger) error {
+
+	var endpointsToBeDeleted []*string
+	var token *string
+	for {
+		endpointList, err := client.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+			Filters:   []*ec2.Filter{{Name: aws.String("vpc-endpoint-type"), Values: []*string{aws.String(gatewayLoadBalancerEndpointType)}}},
+			NextToken: token,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to list Gateway Load Balancer endpoints")
+			return err
+		}
+
+		for _, endpoint := range endpointList.VpcEndpoints {
+			endpointsToBeDeleted = append(endpointsToBeDeleted, endpoint.VpcEndpointId)
+		}
+
+		if endpointList.NextToken != nil {
+			token = endpointList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if endpointsToBeDeleted == nil {
+		return nil
+	}
+
+	output, err := client.DeleteVpcEndpoints(&ec2.DeleteVpcEndpointsInput{VpcEndpointIds: endpointsToBeDeleted})
+	if err != nil {
+		logger.Error(err, "Failed to delete Gateway Load Balancer endpoints")
+		return err
+	}
+	if len(output.Unsuccessful) > 0 {
+		for _, item := range output.Unsuccessful {
+			logger.Info("Failed to delete Gateway Load Balancer endpoint", "ID", aws.StringValue(item.ResourceId), "Error", item.Error.String())
+		}
+		return errors.New("FailedComprehensiveGatewayLoadBalancerEndpointDeletion")
+	}
+	return nil
+}
+
+// ListOrphanTargetGroupsForDeletion returns the ARNs of the target groups that are not used by any load balancer
+func ListOrphanTargetGroupsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var targetGroupsToBeDeleted []*string
+	var marker *string
+	for {
+		targetGroupList, err := client.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list target groups")
+			return nil, err
+		}
+
+		for _, targetGroup := range targetGroupList.TargetGroups {
+			if len(targetGroup.LoadBalancerArns) == 0 {
+				targetGroupsToBeDeleted = append(targetGroupsToBeDeleted, targetGroup.TargetGroupArn)
+			}
+		}
+
+		if targetGroupList.NextMarker != nil {
+			marker = targetGroupList.NextMarker
+		} else {
+			break
+		}
+	}
+	return targetGroupsToBeDeleted, nil
+}
+
+// DeleteTargetGroups deletes the given target groups
+func DeleteTargetGroups(client clientpkg.Client, targetGroupsToBeDeleted []*string, logger logr.Logger) error {
+
+	if targetGroupsToBeDeleted == nil {
+		return nil
+	}
+	var targetGroupsNotDeleted []*string
+	for _, targetGroupArn := range targetGroupsToBeDeleted {
+		_, err := client.DeleteTargetGroup(&elbv2.DeleteTargetGroupInput{TargetGroupArn: targetGroupArn})
+		if err != nil {
+			logger.Error(err, "Failed to delete target group", "ARN", *targetGroupArn)
+			targetGroupsNotDeleted = append(targetGroupsNotDeleted, targetGroupArn)
+			localMetrics.ResourceFail(localMetrics.TargetGroup, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.TargetGroup, client.GetRegion())
+	}
+
+	if targetGroupsNotDeleted != nil {
+		return errors.New("FailedComprehensiveTargetGroupDeletion")
+	}
+	return nil
+}
+
+// CleanElbv2 removes Gateway Load Balancer endpoints, then every ELBv2 load balancer in the region, including those in the default VPC,
+// and finally the target groups that are no longer attached to a load balancer
+func CleanElbv2(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+
+	if err := DeleteGatewayLoadBalancerEndpoints(client, logger); err != nil {
+		errFlag = true
+	}
+
+	loadBalancersToBeDeleted, err := ListLoadBalancersV2ForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteLoadBalancersV2(client, loadBalancersToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete load balancers")
+		errFlag = true
+	}
+
+	targetGroupsToBeDeleted, err := ListOrphanTargetGroupsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteTargetGroups(client, targetGroupsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete target groups")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanElbv2")
+	}
+	logger.Info("All ELBv2 load balancers and target groups have been deleted for this region")
+	return nil
+}
@@ -0,0 +1,162 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// defaultEventBusName is the event bus every account has, it can not be deleted
+const defaultEventBusName = "default"
+
+// ListEventBusesForDeletion returns the names of all event buses in the region, including the default one
+func ListEventBusesForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var eventBuses []*string
+	var token *string
+	for {
+		eventBusList, err := client.ListEventBuses(&eventbridge.ListEventBusesInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list EventBridge event buses")
+			return nil, err
+		}
+
+		for _, eventBus := range eventBusList.EventBuses {
+			eventBuses = append(eventBuses, eventBus.Name)
+		}
+
+		if eventBusList.NextToken != nil {
+			token = eventBusList.NextToken
+		} else {
+			break
+		}
+	}
+	return eventBuses, nil
+}
+
+// DeleteEventBridgeRules removes the targets of every rule on the given event bus and deletes the rules
+// rules managed by other AWS services need to be force deleted
+func DeleteEventBridgeRules(client clientpkg.Client, eventBusName *string, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		ruleList, err := client.ListRules(&eventbridge.ListRulesInput{EventBusName: eventBusName, NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list EventBridge rules", "EventBus", *eventBusName)
+			return err
+		}
+
+		for _, rule := range ruleList.Rules {
+			force := aws.Bool(rule.ManagedBy != nil)
+
+			err := removeEventBridgeTargets(client, eventBusName, rule.Name, force)
+			if err != nil {
+				logger.Error(err, "Failed to remove targets from EventBridge rule", "Name", *rule.Name)
+			}
+
+			_, err = client.DeleteRule(&eventbridge.DeleteRuleInput{EventBusName: eventBusName, Name: rule.Name, Force: force})
+			if err != nil {
+				logger.Error(err, "Failed to delete EventBridge rule", "Name", *rule.Name)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.EventBridgeRule, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.EventBridgeRule, client.GetRegion())
+		}
+
+		if ruleList.NextToken != nil {
+			token = ruleList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveEventBridgeRuleDeletion")
+	}
+	return nil
+}
+
+// removeEventBridgeTargets removes all targets from a rule, a rule can not be deleted while it still has targets
+func removeEventBridgeTargets(client clientpkg.Client, eventBusName *string, ruleName *string, force *bool) error {
+	var token *string
+	for {
+		targetList, err := client.ListTargetsByRule(&eventbridge.ListTargetsByRuleInput{EventBusName: eventBusName, Rule: ruleName, NextToken: token})
+		if err != nil {
+			return err
+		}
+
+		var targetIDs []*string
+		for _, target := range targetList.Targets {
+			targetIDs = append(targetIDs, target.Id)
+		}
+
+		if targetIDs != nil {
+			_, err = client.RemoveTargets(&eventbridge.RemoveTargetsInput{EventBusName: eventBusName, Rule: ruleName, Ids: targetIDs, Force: force})
+			if err != nil {
+				return err
+			}
+		}
+
+		if targetList.NextToken != nil {
+			token = targetList.NextToken
+		} else {
+			break
+		}
+	}
+	return nil
+}
+
+// DeleteEventBuses deletes the rules on every given event bus, then the bus itself unless it is the default bus
+func DeleteEventBuses(client clientpkg.Client, eventBusesToBeDeleted []*string, logger logr.Logger) error {
+
+	if eventBusesToBeDeleted == nil {
+		return nil
+	}
+	errFlag := false
+	for _, eventBusName := range eventBusesToBeDeleted {
+
+		err := DeleteEventBridgeRules(client, eventBusName, logger)
+		if err != nil {
+			errFlag = true
+		}
+
+		if *eventBusName == defaultEventBusName {
+			continue
+		}
+
+		_, err = client.DeleteEventBus(&eventbridge.DeleteEventBusInput{Name: eventBusName})
+		if err != nil {
+			logger.Error(err, "Failed to delete EventBridge event bus", "Name", *eventBusName)
+			errFlag = true
+			localMetrics.ResourceFail(localMetrics.EventBridgeBus, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.EventBridgeBus, client.GetRegion())
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveEventBusDeletion")
+	}
+	return nil
+}
+
+// CleanEventBridge lists and deletes EventBridge rules and custom event buses
+func CleanEventBridge(client clientpkg.Client, logger logr.Logger) error {
+	eventBusesToBeDeleted, err := ListEventBusesForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+	err = DeleteEventBuses(client, eventBusesToBeDeleted, logger)
+	if err != nil {
+		logger.Error(err, "Failed to delete EventBridge resources")
+		return err
+	}
+	logger.Info("All EventBridge rules and event buses have been deleted for this region")
+	return nil
+}
@@ -0,0 +1,179 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListAcceleratorsForDeletion returns every Global Accelerator accelerator of the account
+func ListAcceleratorsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*globalaccelerator.Accelerator, error) {
+
+	var acceleratorsToBeDeleted []*globalaccelerator.Accelerator
+	var token *string
+	for {
+		acceleratorList, err := client.ListAccelerators(&globalaccelerator.ListAcceleratorsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list Global Accelerator accelerators")
+			return nil, err
+		}
+
+		acceleratorsToBeDeleted = append(acceleratorsToBeDeleted, acceleratorList.Accelerators...)
+
+		if acceleratorList.NextToken != nil {
+			token = acceleratorList.NextToken
+		} else {
+			break
+		}
+	}
+	return acceleratorsToBeDeleted, nil
+}
+
+// DeleteAcceleratorListeners deletes the endpoint groups and listeners of the accelerator
+func DeleteAcceleratorListeners(client clientpkg.Client, acceleratorArn *string, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		listenerList, err := client.ListListeners(&globalaccelerator.ListListenersInput{AcceleratorArn: acceleratorArn, NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list accelerator listeners", "ARN", *acceleratorArn)
+			return err
+		}
+
+		for _, listener := range listenerList.Listeners {
+			if err = deleteListenerEndpointGroups(client, listener.ListenerArn, logger); err != nil {
+				errFlag = true
+				continue
+			}
+			_, err = client.DeleteListener(&globalaccelerator.DeleteListenerInput{ListenerArn: listener.ListenerArn})
+			if err != nil {
+				logger.Error(err, "Failed to delete accelerator listener", "ARN", aws.StringValue(listener.ListenerArn))
+				errFlag = true
+			}
+		}
+
+		if listenerList.NextToken != nil {
+			token = listenerList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveAcceleratorListenerDeletion")
+	}
+	return nil
+}
+
+// deleteListenerEndpointGroups deletes the endpoint groups of an accelerator listener
+func deleteListenerEndpointGroups(client clientpkg.Client, listenerArn *string, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		endpointGroupList, err := client.ListEndpointGroups(&globalaccelerator.ListEndpointGroupsInput{ListenerArn: listenerArn, NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list accelerator endpoint groups", "ListenerARN", *listenerArn)
+			return err
+		}
+
+		for _, endpointGroup := range endpointGroupList.EndpointGroups {
+			_, err = client.DeleteEndpointGroup(&globalaccelerator.DeleteEndpointGroupInput{EndpointGroupArn: endpointGroup.EndpointGroupArn})
+			if err != nil {
+				logger.Error(err, "Failed to delete accelerator endpoint group", "ARN", aws.StringValue(endpointGroup.EndpointGroupArn))
+				errFlag = true
+			}
+		}
+
+		if endpointGroupList.NextToken != nil {
+			token = endpointGroupList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveAcceleratorEndpointGroupDeletion")
+	}
+	return nil
+}
+
+// DeleteAccelerators takes each accelerator one step further through its deletion lifecycle:
+// listeners and endpoint groups are removed, enabled accelerators are disabled, and disabled ones are deleted once deployed
+// ErrDeletionPending is returned when nothing failed but accelerators are left for a later pass
+func DeleteAccelerators(client clientpkg.Client, acceleratorsToBeDeleted []*globalaccelerator.Accelerator, logger logr.Logger) error {
+
+	if acceleratorsToBeDeleted == nil {
+		return nil
+	}
+	var acceleratorsNotDeleted []*string
+	pending := false
+	for _, accelerator := range acceleratorsToBeDeleted {
+		if err := DeleteAcceleratorListeners(client, accelerator.AcceleratorArn, logger); err != nil {
+			acceleratorsNotDeleted = append(acceleratorsNotDeleted, accelerator.AcceleratorArn)
+			localMetrics.ResourceFail(localMetrics.Accelerator, client.GetRegion())
+			continue
+		}
+
+		if aws.BoolValue(accelerator.Enabled) {
+			_, err := client.UpdateAccelerator(&globalaccelerator.UpdateAcceleratorInput{AcceleratorArn: accelerator.AcceleratorArn, Enabled: aws.Bool(false)})
+			if err != nil {
+				logger.Error(err, "Failed to disable accelerator", "ARN", *accelerator.AcceleratorArn)
+				acceleratorsNotDeleted = append(acceleratorsNotDeleted, accelerator.AcceleratorArn)
+				localMetrics.ResourceFail(localMetrics.Accelerator, client.GetRegion())
+				continue
+			}
+			logger.Info("Accelerator disabled, it is deleted once the change has deployed", "ARN", *accelerator.AcceleratorArn)
+			pending = true
+			continue
+		}
+
+		if aws.StringValue(accelerator.Status) == globalaccelerator.AcceleratorStatusInProgress {
+			logger.Info("Accelerator is still being disabled", "ARN", *accelerator.AcceleratorArn)
+			pending = true
+			continue
+		}
+
+		_, err := client.DeleteAccelerator(&globalaccelerator.DeleteAcceleratorInput{AcceleratorArn: accelerator.AcceleratorArn})
+		if err != nil {
+			logger.Error(err, "Failed to delete accelerator", "ARN", *accelerator.AcceleratorArn)
+			acceleratorsNotDeleted = append(acceleratorsNotDeleted, accelerator.AcceleratorArn)
+			localMetrics.ResourceFail(localMetrics.Accelerator, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.Accelerator, client.GetRegion())
+	}
+
+	if acceleratorsNotDeleted != nil {
+		return errors.New("FailedComprehensiveAcceleratorDeletion")
+	}
+	if pending {
+		return ErrDeletionPending
+	}
+	return nil
+}
+
+// CleanGlobalAccelerator disables and deletes Global Accelerator accelerators, it runs in the global pass
+// the client reaches the Global Accelerator API in us-west-2, the only region serving it
+func CleanGlobalAccelerator(client clientpkg.Client, logger logr.Logger) error {
+	acceleratorsToBeDeleted, err := ListAcceleratorsForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+	err = DeleteAccelerators(client, acceleratorsToBeDeleted, logger)
+	if err == ErrDeletionPending {
+		logger.Info("Accelerators are being disabled and will be deleted on a later pass")
+		return err
+	}
+	if err != nil {
+		logger.Error(err, "Failed to delete accelerators")
+		return err
+	}
+	logger.Info("All Global Accelerator accelerators have been deleted for this account")
+	return nil
+}
@@ -0,0 +1,135 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListKinesisStreamsForDeletion returns the names of all Kinesis data streams in the region
+func ListKinesisStreamsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var streamsToBeDeleted []*string
+	var lastStreamName *string
+	for {
+		streamList, err := client.ListStreams(&kinesis.ListStreamsInput{ExclusiveStartStreamName: lastStreamName})
+		if err != nil {
+			logger.Error(err, "Failed to list Kinesis streams")
+			return nil, err
+		}
+
+		streamsToBeDeleted = append(streamsToBeDeleted, streamList.StreamNames...)
+
+		if aws.BoolValue(streamList.HasMoreStreams) && len(streamList.StreamNames) > 0 {
+			lastStreamName = streamList.StreamNames[len(streamList.StreamNames)-1]
+		} else {
+			break
+		}
+	}
+	return streamsToBeDeleted, nil
+}
+
+// DeleteKinesisStreams deletes the given streams, deregistering any enhanced fan-out consumers along the way
+func DeleteKinesisStreams(client clientpkg.Client, streamsToBeDeleted []*string, logger logr.Logger) error {
+
+	if streamsToBeDeleted == nil {
+		return nil
+	}
+	var streamsNotDeleted []*string
+	for _, streamName := range streamsToBeDeleted {
+		_, err := client.DeleteStream(&kinesis.DeleteStreamInput{StreamName: streamName, EnforceConsumerDeletion: aws.Bool(true)})
+		if err != nil {
+			logger.Error(err, "Failed to delete Kinesis stream", "Name", *streamName)
+			streamsNotDeleted = append(streamsNotDeleted, streamName)
+			localMetrics.ResourceFail(localMetrics.KinesisStream, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.KinesisStream, client.GetRegion())
+	}
+
+	if streamsNotDeleted != nil {
+		return errors.New("FailedComprehensiveKinesisStreamDeletion")
+	}
+	return nil
+}
+
+// ListFirehoseStreamsForDeletion returns the names of all Firehose delivery streams in the region
+func ListFirehoseStreamsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var streamsToBeDeleted []*string
+	var lastStreamName *string
+	for {
+		streamList, err := client.ListDeliveryStreams(&firehose.ListDeliveryStreamsInput{ExclusiveStartDeliveryStreamName: lastStreamName})
+		if err != nil {
+			logger.Error(err, "Failed to list Firehose delivery streams")
+			return nil, err
+		}
+
+		streamsToBeDeleted = append(streamsToBeDeleted, streamList.DeliveryStreamNames...)
+
+		if aws.BoolValue(streamList.HasMoreDeliveryStreams) && len(streamList.DeliveryStreamNames) > 0 {
+			lastStreamName = streamList.DeliveryStreamNames[len(streamList.DeliveryStreamNames)-1]
+		} else {
+			break
+		}
+	}
+	return streamsToBeDeleted, nil
+}
+
+// DeleteFirehoseStreams deletes the given delivery streams
+func DeleteFirehoseStreams(client clientpkg.Client, streamsToBeDeleted []*string, logger logr.Logger) error {
+
+	if streamsToBeDeleted == nil {
+		return nil
+	}
+	var streamsNotDeleted []*string
+	for _, streamName := range streamsToBeDeleted {
+		_, err := client.DeleteDeliveryStream(&firehose.DeleteDeliveryStreamInput{DeliveryStreamName: streamName, AllowForceDelete: aws.Bool(true)})
+		if err != nil {
+			logger.Error(err, "Failed to delete Firehose delivery stream", "Name", *streamName)
+			streamsNotDeleted = append(streamsNotDeleted, streamName)
+			localMetrics.ResourceFail(localMetrics.FirehoseStream, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.FirehoseStream, client.GetRegion())
+	}
+
+	if streamsNotDeleted != nil {
+		return errors.New("FailedComprehensiveFirehoseStreamDeletion")
+	}
+	return nil
+}
+
+// CleanKinesis lists and deletes Kinesis data streams and Firehose delivery streams
+// delivery streams go first as they may be reading from a data stream
+func CleanKinesis(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+
+	firehoseStreamsToBeDeleted, err := ListFirehoseStreamsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteFirehoseStreams(client, firehoseStreamsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete Firehose delivery streams")
+		errFlag = true
+	}
+
+	kinesisStreamsToBeDeleted, err := ListKinesisStreamsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteKinesisStreams(client, kinesisStreamsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete Kinesis streams")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanKinesis")
+	}
+	logger.Info("All Kinesis and Firehose streams have been deleted for this region")
+	return nil
+}
@@ -0,0 +1,171 @@
+package awsManager
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// awsManagedAliasPrefix is reserved for aliases of AWS managed keys, those can not be deleted
+const awsManagedAliasPrefix = "alias/aws/"
+
+// ListKmsKeysForDeletion returns the IDs of the customer managed keys in the region that are not yet scheduled for deletion
+// keys that could not be described are left out and reported through the returned error
+func ListKmsKeysForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var keysToBeDeleted []*string
+	describeFailed := false
+	var marker *string
+	for {
+		keyList, err := client.ListKeys(&kms.ListKeysInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list KMS keys")
+			return nil, err
+		}
+
+		for _, key := range keyList.Keys {
+			keyDescription, err := client.DescribeKey(&kms.DescribeKeyInput{KeyId: key.KeyId})
+			if err != nil {
+				logger.Error(err, "Failed to describe KMS key", "ID", *key.KeyId)
+				describeFailed = true
+				continue
+			}
+
+			metadata := keyDescription.KeyMetadata
+			if aws.StringValue(metadata.KeyManager) != kms.KeyManagerTypeCustomer {
+				continue
+			}
+			if aws.StringValue(metadata.KeyState) == kms.KeyStatePendingDeletion {
+				logger.Info("KMS key is already pending deletion", "ID", *key.KeyId, "DeletionDate", aws.TimeValue(metadata.DeletionDate))
+				continue
+			}
+			keysToBeDeleted = append(keysToBeDeleted, key.KeyId)
+		}
+
+		if aws.BoolValue(keyList.Truncated) {
+			marker = keyList.NextMarker
+		} else {
+			break
+		}
+	}
+
+	if describeFailed {
+		return keysToBeDeleted, errors.New("FailedToDescribeKmsKeys")
+	}
+	return keysToBeDeleted, nil
+}
+
+// ScheduleKmsKeyDeletion disables the given keys and schedules them for deletion after pendingWindowDays
+// keys can not be deleted immediately, once scheduled they no longer count against the account being shredded
+func ScheduleKmsKeyDeletion(client clientpkg.Client, keysToBeDeleted []*string, pendingWindowDays int64, logger logr.Logger) error {
+
+	if keysToBeDeleted == nil {
+		return nil
+	}
+	var keysNotDeleted []*string
+	for _, keyID := range keysToBeDeleted {
+		_, err := client.DisableKey(&kms.DisableKeyInput{KeyId: keyID})
+		if err != nil {
+			logger.Error(err, "Failed to disable KMS key", "ID", *keyID)
+		}
+
+		_, err = client.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{KeyId: keyID, PendingWindowInDays: aws.Int64(pendingWindowDays)})
+		if err != nil {
+			// the key may have been scheduled since it was listed
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == kms.ErrCodeInvalidStateException && isKmsKeyPendingDeletion(client, keyID) {
+				logger.Info("KMS key is already pending deletion", "ID", *keyID)
+				continue
+			}
+			logger.Error(err, "Failed to schedule KMS key deletion", "ID", *keyID)
+			keysNotDeleted = append(keysNotDeleted, keyID)
+			localMetrics.ResourceFail(localMetrics.KmsKey, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.KmsKey, client.GetRegion())
+	}
+
+	if keysNotDeleted != nil {
+		return errors.New("FailedComprehensiveKmsKeyDeletion")
+	}
+	return nil
+}
+
+// isKmsKeyPendingDeletion reports whether the key is currently scheduled for deletion
+func isKmsKeyPendingDeletion(client clientpkg.Client, keyID *string) bool {
+	keyDescription, err := client.DescribeKey(&kms.DescribeKeyInput{KeyId: keyID})
+	if err != nil {
+		return false
+	}
+	return aws.StringValue(keyDescription.KeyMetadata.KeyState) == kms.KeyStatePendingDeletion
+}
+
+// DeleteKmsAliases deletes every alias in the region that does not belong to an AWS managed key
+func DeleteKmsAliases(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+	var marker *string
+	for {
+		aliasList, err := client.ListAliases(&kms.ListAliasesInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list KMS aliases")
+			return err
+		}
+
+		for _, alias := range aliasList.Aliases {
+			if strings.HasPrefix(*alias.AliasName, awsManagedAliasPrefix) {
+				continue
+			}
+			_, err := client.DeleteAlias(&kms.DeleteAliasInput{AliasName: alias.AliasName})
+			if err != nil {
+				logger.Error(err, "Failed to delete KMS alias", "Name", *alias.AliasName)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.KmsAlias, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.KmsAlias, client.GetRegion())
+		}
+
+		if aws.BoolValue(aliasList.Truncated) {
+			marker = aliasList.NextMarker
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveKmsAliasDeletion")
+	}
+	return nil
+}
+
+// CleanKms deletes customer aliases and schedules every customer managed key for deletion
+// AWS managed keys are skipped, and keys already pending deletion are considered clean
+func CleanKms(client clientpkg.Client, pendingWindowDays int64, logger logr.Logger) error {
+
+	errFlag := false
+	if err := DeleteKmsAliases(client, logger); err != nil {
+		logger.Error(err, "Failed to delete KMS aliases")
+		errFlag = true
+	}
+
+	keysToBeDeleted, err := ListKmsKeysForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	}
+	if err = ScheduleKmsKeyDeletion(client, keysToBeDeleted, pendingWindowDays, logger); err != nil {
+		logger.Error(err, "Failed to schedule KMS key deletion")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanKms")
+	}
+	logger.Info("All customer managed KMS keys have been scheduled for deletion for this region")
+	return nil
+}
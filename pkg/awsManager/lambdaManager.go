@@ -0,0 +1,197 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListLambdaEventSourceMappingsForDeletion returns the UUIDs of all event source mappings in the region
+func ListLambdaEventSourceMappingsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var mappingsToBeDeleted []*string
+	var marker *string
+	for {
+		mappingList, err := client.ListEventSourceMappings(&lambda.ListEventSourceMappingsInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list Lambda event source mappings")
+			return nil, err
+		}
+
+		for _, mapping := range mappingList.EventSourceMappings {
+			mappingsToBeDeleted = append(mappingsToBeDeleted, mapping.UUID)
+		}
+
+		if mappingList.NextMarker != nil {
+			marker = mappingList.NextMarker
+		} else {
+			break
+		}
+	}
+	return mappingsToBeDeleted, nil
+}
+
+// DeleteLambdaEventSourceMappings deletes the given event source mappings
+func DeleteLambdaEventSourceMappings(client clientpkg.Client, mappingsToBeDeleted []*string, logger logr.Logger) error {
+
+	if mappingsToBeDeleted == nil {
+		return nil
+	}
+	var mappingsNotDeleted []*string
+	for _, mappingID := range mappingsToBeDeleted {
+		_, err := client.DeleteEventSourceMapping(&lambda.DeleteEventSourceMappingInput{UUID: mappingID})
+		if err != nil {
+			logger.Error(err, "Failed to delete Lambda event source mapping", "UUID", *mappingID)
+			mappingsNotDeleted = append(mappingsNotDeleted, mappingID)
+			localMetrics.ResourceFail(localMetrics.LambdaEventMapping, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.LambdaEventMapping, client.GetRegion())
+	}
+
+	if mappingsNotDeleted != nil {
+		return errors.New("FailedComprehensiveEventSourceMappingDeletion")
+	}
+	return nil
+}
+
+// ListLambdaFunctionsForDeletion returns the names of all Lambda functions in the region
+func ListLambdaFunctionsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var functionsToBeDeleted []*string
+	var marker *string
+	for {
+		functionList, err := client.ListFunctions(&lambda.ListFunctionsInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list Lambda functions")
+			return nil, err
+		}
+
+		for _, function := range functionList.Functions {
+			functionsToBeDeleted = append(functionsToBeDeleted, function.FunctionName)
+		}
+
+		if functionList.NextMarker != nil {
+			marker = functionList.NextMarker
+		} else {
+			break
+		}
+	}
+	return functionsToBeDeleted, nil
+}
+
+// DeleteLambdaFunctions deletes the given Lambda functions, including all of their versions and aliases
+func DeleteLambdaFunctions(client clientpkg.Client, functionsToBeDeleted []*string, logger logr.Logger) error {
+
+	if functionsToBeDeleted == nil {
+		return nil
+	}
+	var functionsNotDeleted []*string
+	for _, functionName := range functionsToBeDeleted {
+		_, err := client.DeleteFunction(&lambda.DeleteFunctionInput{FunctionName: functionName})
+		if err != nil {
+			logger.Error(err, "Failed to delete Lambda function", "Name", *functionName)
+			functionsNotDeleted = append(functionsNotDeleted, functionName)
+			localMetrics.ResourceFail(localMetrics.LambdaFunction, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.LambdaFunction, client.GetRegion())
+	}
+
+	if functionsNotDeleted != nil {
+		return errors.New("FailedComprehensiveLambdaFunctionDeletion")
+	}
+	return nil
+}
+
+// DeleteLambdaLayers deletes every version of every Lambda layer in the region
+// a layer disappears on its own once its last version is deleted
+func DeleteLambdaLayers(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+	var marker *string
+	for {
+		layerList, err := client.ListLayers(&lambda.ListLayersInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list Lambda layers")
+			return err
+		}
+
+		for _, layer := range layerList.Layers {
+			var versionMarker *string
+			for {
+				versionList, err := client.ListLayerVersions(&lambda.ListLayerVersionsInput{LayerName: layer.LayerArn, Marker: versionMarker})
+				if err != nil {
+					logger.Error(err, "Failed to list Lambda layer versions", "Name", *layer.LayerName)
+					errFlag = true
+					break
+				}
+
+				for _, version := range versionList.LayerVersions {
+					_, err := client.DeleteLayerVersion(&lambda.DeleteLayerVersionInput{LayerName: layer.LayerArn, VersionNumber: version.Version})
+					if err != nil {
+						logger.Error(err, "Failed to delete Lambda layer version", "ARN", *version.LayerVersionArn)
+						errFlag = true
+						localMetrics.ResourceFail(localMetrics.LambdaLayerVersion, client.GetRegion())
+						continue
+					}
+					localMetrics.ResourceSuccess(localMetrics.LambdaLayerVersion, client.GetRegion())
+				}
+
+				if versionList.NextMarker != nil {
+					versionMarker = versionList.NextMarker
+				} else {
+					break
+				}
+			}
+		}
+
+		if layerList.NextMarker != nil {
+			marker = layerList.NextMarker
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveLambdaLayerDeletion")
+	}
+	return nil
+}
+
+// CleanLambda removes event source mappings, functions and layers
+// Lambda functions attached to a VPC hold hyperplane ENIs, so this has to run before CleanVpcInstances
+func CleanLambda(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+
+	mappingsToBeDeleted, err := ListLambdaEventSourceMappingsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteLambdaEventSourceMappings(client, mappingsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete Lambda event source mappings")
+		errFlag = true
+	}
+
+	functionsToBeDeleted, err := ListLambdaFunctionsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteLambdaFunctions(client, functionsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete Lambda functions")
+		errFlag = true
+	}
+
+	if err = DeleteLambdaLayers(client, logger); err != nil {
+		logger.Error(err, "Failed to delete Lambda layers")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanLambda")
+	}
+	logger.Info("All Lambda resources have been deleted for this region")
+	return nil
+}
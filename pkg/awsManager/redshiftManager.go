@@ -0,0 +1,203 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// defaultRedshiftSubnetGroupName is created by AWS and can not be deleted
+const defaultRedshiftSubnetGroupName = "default"
+
+// ListRedshiftClustersForDeletion returns the identifiers of the Redshift clusters in the region that are not already being deleted
+func ListRedshiftClustersForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var clustersToBeDeleted []*string
+	var marker *string
+	for {
+		clusterList, err := client.DescribeClustersRedshift(&redshift.DescribeClustersInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list Redshift clusters")
+			return nil, err
+		}
+
+		for _, cluster := range clusterList.Clusters {
+			if aws.StringValue(cluster.ClusterStatus) != "deleting" {
+				clustersToBeDeleted = append(clustersToBeDeleted, cluster.ClusterIdentifier)
+			}
+		}
+
+		if clusterList.Marker != nil {
+			marker = clusterList.Marker
+		} else {
+			break
+		}
+	}
+	return clustersToBeDeleted, nil
+}
+
+// DeleteRedshiftClusters deletes the given clusters without taking a final snapshot
+func DeleteRedshiftClusters(client clientpkg.Client, clustersToBeDeleted []*string, logger logr.Logger) error {
+
+	if clustersToBeDeleted == nil {
+		return nil
+	}
+	var clustersNotDeleted []*string
+	for _, clusterID := range clustersToBeDeleted {
+		_, err := client.DeleteClusterRedshift(&redshift.DeleteClusterInput{ClusterIdentifier: clusterID, SkipFinalClusterSnapshot: aws.Bool(true)})
+		if err != nil {
+			logger.Error(err, "Failed to delete Redshift cluster", "ID", *clusterID)
+			clustersNotDeleted = append(clustersNotDeleted, clusterID)
+			localMetrics.ResourceFail(localMetrics.RedshiftCluster, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.RedshiftCluster, client.GetRegion())
+	}
+
+	if clustersNotDeleted != nil {
+		return errors.New("FailedComprehensiveRedshiftClusterDeletion")
+	}
+	return nil
+}
+
+// ListRedshiftSnapshotsForDeletion returns the identifiers of the manual Redshift snapshots in the region
+// automated snapshots are removed by AWS along with their cluster
+func ListRedshiftSnapshotsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var snapshotsToBeDeleted []*string
+	var marker *string
+	for {
+		snapshotList, err := client.DescribeClusterSnapshots(&redshift.DescribeClusterSnapshotsInput{SnapshotType: aws.String("manual"), Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list Redshift snapshots")
+			return nil, err
+		}
+
+		for _, snapshot := range snapshotList.Snapshots {
+			snapshotsToBeDeleted = append(snapshotsToBeDeleted, snapshot.SnapshotIdentifier)
+		}
+
+		if snapshotList.Marker != nil {
+			marker = snapshotList.Marker
+		} else {
+			break
+		}
+	}
+	return snapshotsToBeDeleted, nil
+}
+
+// DeleteRedshiftSnapshots deletes the given snapshots
+func DeleteRedshiftSnapshots(client clientpkg.Client, snapshotsToBeDeleted []*string, logger logr.Logger) error {
+
+	if snapshotsToBeDeleted == nil {
+		return nil
+	}
+	var snapshotsNotDeleted []*string
+	for _, snapshotID := range snapshotsToBeDeleted {
+		_, err := client.DeleteClusterSnapshot(&redshift.DeleteClusterSnapshotInput{SnapshotIdentifier: snapshotID})
+		if err != nil {
+			logger.Error(err, "Failed to delete Redshift snapshot", "ID", *snapshotID)
+			snapshotsNotDeleted = append(snapshotsNotDeleted, snapshotID)
+			localMetrics.ResourceFail(localMetrics.RedshiftSnapshot, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.RedshiftSnapshot, client.GetRegion())
+	}
+
+	if snapshotsNotDeleted != nil {
+		return errors.New("FailedComprehensiveRedshiftSnapshotDeletion")
+	}
+	return nil
+}
+
+// ListRedshiftSubnetGroupsForDeletion returns the names of the cluster subnet groups in the region, except for the default one
+func ListRedshiftSubnetGroupsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var subnetGroupsToBeDeleted []*string
+	var marker *string
+	for {
+		subnetGroupList, err := client.DescribeClusterSubnetGroups(&redshift.DescribeClusterSubnetGroupsInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list Redshift subnet groups")
+			return nil, err
+		}
+
+		for _, subnetGroup := range subnetGroupList.ClusterSubnetGroups {
+			if aws.StringValue(subnetGroup.ClusterSubnetGroupName) != defaultRedshiftSubnetGroupName {
+				subnetGroupsToBeDeleted = append(subnetGroupsToBeDeleted, subnetGroup.ClusterSubnetGroupName)
+			}
+		}
+
+		if subnetGroupList.Marker != nil {
+			marker = subnetGroupList.Marker
+		} else {
+			break
+		}
+	}
+	return subnetGroupsToBeDeleted, nil
+}
+
+// DeleteRedshiftSubnetGroups deletes the given subnet groups
+// a subnet group still used by a cluster that is being deleted fails, and is picked up again on the next pass
+func DeleteRedshiftSubnetGroups(client clientpkg.Client, subnetGroupsToBeDeleted []*string, logger logr.Logger) error {
+
+	if subnetGroupsToBeDeleted == nil {
+		return nil
+	}
+	var subnetGroupsNotDeleted []*string
+	for _, subnetGroupName := range subnetGroupsToBeDeleted {
+		_, err := client.DeleteClusterSubnetGroup(&redshift.DeleteClusterSubnetGroupInput{ClusterSubnetGroupName: subnetGroupName})
+		if err != nil {
+			logger.Error(err, "Failed to delete Redshift subnet group", "Name", *subnetGroupName)
+			subnetGroupsNotDeleted = append(subnetGroupsNotDeleted, subnetGroupName)
+			localMetrics.ResourceFail(localMetrics.RedshiftSubnetGroup, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.RedshiftSubnetGroup, client.GetRegion())
+	}
+
+	if subnetGroupsNotDeleted != nil {
+		return errors.New("FailedComprehensiveRedshiftSubnetGroupDeletion")
+	}
+	return nil
+}
+
+// CleanRedshift deletes Redshift clusters, manual snapshots and subnet groups
+func CleanRedshift(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+
+	clustersToBeDeleted, err := ListRedshiftClustersForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteRedshiftClusters(client, clustersToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete Redshift clusters")
+		errFlag = true
+	}
+
+	snapshotsToBeDeleted, err := ListRedshiftSnapshotsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteRedshiftSnapshots(client, snapshotsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete Redshift snapshots")
+		errFlag = true
+	}
+
+	subnetGroupsToBeDeleted, err := ListRedshiftSubnetGroupsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteRedshiftSubnetGroups(client, subnetGroupsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete Redshift subnet groups")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanRedshift")
+	}
+	logger.Info("All Redshift resources have been deleted for this region")
+	return nil
+}
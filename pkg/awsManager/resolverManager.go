@@ -0,0 +1,409 @@
+package awsManager
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// resolverAutodefinedPrefix is the ID prefix of the rules, and their associations, Route53 Resolver defines on its own
+const resolverAutodefinedPrefix = "rslvr-autodefined-"
+
+// DisassociateResolverRules removes every association between a resolver rule and a VPC in the region
+// a rule can not be deleted while it is associated with a VPC
+func DisassociateResolverRules(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		associationList, err := client.ListResolverRuleAssociations(&route53resolver.ListResolverRuleAssociationsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list resolver rule associations")
+			return err
+		}
+
+		for _, association := range associationList.ResolverRuleAssociations {
+			if strings.HasPrefix(aws.StringValue(association.Id), resolverAutodefinedPrefix) || aws.StringValue(association.Status) == route53resolver.ResolverRuleAssociationStatusDeleting {
+				continue
+			}
+			_, err = client.DisassociateResolverRule(&route53resolver.DisassociateResolverRuleInput{ResolverRuleId: association.ResolverRuleId, VPCId: association.VPCId})
+			if err != nil {
+				logger.Error(err, "Failed to disassociate resolver rule", "RuleID", aws.StringValue(association.ResolverRuleId), "VpcID", aws.StringValue(association.VPCId))
+				errFlag = true
+			}
+		}
+
+		if associationList.NextToken != nil {
+			token = associationList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveResolverRuleDisassociation")
+	}
+	return nil
+}
+
+// ListResolverRulesForDeletion returns the IDs of the resolver rules owned by the account
+// system defined rules and rules shared by other accounts can not be deleted from here
+func ListResolverRulesForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var rulesToBeDeleted []*string
+	var token *string
+	for {
+		ruleList, err := client.ListResolverRules(&route53resolver.ListResolverRulesInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list resolver rules")
+			return nil, err
+		}
+
+		for _, rule := range ruleList.ResolverRules {
+			if strings.HasPrefix(aws.StringValue(rule.Id), resolverAutodefinedPrefix) ||
+				aws.StringValue(rule.RuleType) == route53resolver.RuleTypeOptionSystem ||
+				aws.StringValue(rule.ShareStatus) == route53resolver.ShareStatusSharedWithMe ||
+				aws.StringValue(rule.Status) == route53resolver.ResolverRuleStatusDeleting {
+				continue
+			}
+			rulesToBeDeleted = append(rulesToBeDeleted, rule.Id)
+		}
+
+		if ruleList.NextToken != nil {
+			token = ruleList.NextToken
+		} else {
+			break
+		}
+	}
+	return rulesToBeDeleted, nil
+}
+
+// DeleteResolverRules deletes the given resolver rules
+func DeleteResolverRules(client clientpkg.Client, rulesToBeDeleted []*string, logger logr.Logger) error {
+
+	if rulesToBeDeleted == nil {
+		return nil
+	}
+	var rulesNotDeleted []*string
+	for _, ruleID := range rulesToBeDeleted {
+		_, err := client.DeleteResolverRule(&route53resolver.DeleteResolverRuleInput{ResolverRuleId: ruleID})
+		if err != nil {
+			logger.Error(err, "Failed to delete resolver rule", "ID", *ruleID)
+			rulesNotDeleted = append(rulesNotDeleted, ruleID)
+			localMetrics.ResourceFail(localMetrics.ResolverRule, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.ResolverRule, client.GetRegion())
+	}
+
+	if rulesNotDeleted != nil {
+		return errors.New("FailedComprehensiveResolverRuleDeletion")
+	}
+	return nil
+}
+
+// ListResolverEndpointsForDeletion returns the IDs of the inbound and outbound resolver endpoints in the region
+func ListResolverEndpointsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var endpointsToBeDeleted []*string
+	var token *string
+	for {
+		endpointList, err := client.ListResolverEndpoints(&route53resolver.ListResolverEndpointsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list resolver endpoints")
+			return nil, err
+		}
+
+		for _, endpoint := range endpointList.ResolverEndpoints {
+			if aws.StringValue(endpoint.Status) != route53resolver.ResolverEndpointStatusDeleting {
+				endpointsToBeDeleted = append(endpointsToBeDeleted, endpoint.Id)
+			}
+		}
+
+		if endpointList.NextToken != nil {
+			token = endpointList.NextToken
+		} else {
+			break
+		}
+	}
+	return endpointsToBeDeleted, nil
+}
+
+// DeleteResolverEndpoints deletes the given resolver endpoints, their network interfaces go with them
+func DeleteResolverEndpoints(client clientpkg.Client, endpointsToBeDeleted []*string, logger logr.Logger) error {
+
+	if endpointsToBeDeleted == nil {
+		return nil
+	}
+	var endpointsNotDeleted []*string
+	for _, endpointID := range endpointsToBeDeleted {
+		_, err := client.DeleteResolverEndpoint(&route53resolver.DeleteResolverEndpointInput{ResolverEndpointId: endpointID})
+		if err != nil {
+			logger.Error(err, "Failed to delete resolver endpoint", "ID", *endpointID)
+			endpointsNotDeleted = append(endpointsNotDeleted, endpointID)
+			localMetrics.ResourceFail(localMetrics.ResolverEndpoint, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.ResolverEndpoint, client.GetRegion())
+	}
+
+	if endpointsNotDeleted != nil {
+		return errors.New("FailedComprehensiveResolverEndpointDeletion")
+	}
+	return nil
+}
+
+// DisassociateResolverQueryLogConfigs removes every association between a query logging config and a VPC in the region
+// a query logging config can not be deleted while it is associated with a VPC
+func DisassociateResolverQueryLogConfigs(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		associationList, err := client.ListResolverQueryLogConfigAssociations(&route53resolver.ListResolverQueryLogConfigAssociationsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list resolver query logging config associations")
+			return err
+		}
+
+		for _, association := range associationList.ResolverQueryLogConfigAssociations {
+			if aws.StringValue(association.Status) == route53resolver.ResolverQueryLogConfigAssociationStatusDeleting {
+				continue
+			}
+			_, err = client.DisassociateResolverQueryLogConfig(&route53resolver.DisassociateResolverQueryLogConfigInput{ResolverQueryLogConfigId: association.ResolverQueryLogConfigId, ResourceId: association.ResourceId})
+			if err != nil {
+				logger.Error(err, "Failed to disassociate resolver query logging config", "ConfigID", aws.StringValue(association.ResolverQueryLogConfigId), "VpcID", aws.StringValue(association.ResourceId))
+				errFlag = true
+			}
+		}
+
+		if associationList.NextToken != nil {
+			token = associationList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveResolverQueryLogConfigDisassociation")
+	}
+	return nil
+}
+
+// ListResolverQueryLogConfigsForDeletion returns the IDs of the query logging configs owned by the account
+// configs shared by other accounts can not be deleted from here
+func ListResolverQueryLogConfigsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var configsToBeDeleted []*string
+	var token *string
+	for {
+		configList, err := client.ListResolverQueryLogConfigs(&route53resolver.ListResolverQueryLogConfigsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list resolver query logging configs")
+			return nil, err
+		}
+
+		for _, config := range configList.ResolverQueryLogConfigs {
+			if aws.StringValue(config.ShareStatus) == route53resolver.ShareStatusSharedWithMe ||
+				aws.StringValue(config.Status) == route53resolver.ResolverQueryLogConfigStatusDeleting {
+				continue
+			}
+			configsToBeDeleted = append(configsToBeDeleted, config.Id)
+		}
+
+		if configList.NextToken != nil {
+			token = configList.NextToken
+		} else {
+			break
+		}
+	}
+	return configsToBeDeleted, nil
+}
+
+// DeleteResolverQueryLogConfigs deletes the given query logging configs, the logs already delivered are kept
+func DeleteResolverQueryLogConfigs(client clientpkg.Client, configsToBeDeleted []*string, logger logr.Logger) error {
+
+	if configsToBeDeleted == nil {
+		return nil
+	}
+	var configsNotDeleted []*string
+	for _, configID := range configsToBeDeleted {
+		_, err := client.DeleteResolverQueryLogConfig(&route53resolver.DeleteResolverQueryLogConfigInput{ResolverQueryLogConfigId: configID})
+		if err != nil {
+			logger.Error(err, "Failed to delete resolver query logging config", "ID", *configID)
+			configsNotDeleted = append(configsNotDeleted, configID)
+			localMetrics.ResourceFail(localMetrics.ResolverQueryLog, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.ResolverQueryLog, client.GetRegion())
+	}
+
+	if configsNotDeleted != nil {
+		return errors.New("FailedComprehensiveResolverQueryLogConfigDeletion")
+	}
+	return nil
+}
+
+// DisassociateFirewallRuleGroups removes every association between a DNS Firewall rule group and a VPC in the region
+// mutation protection is turned off first, and associations managed by another service, such as Firewall Manager, are left to it
+func DisassociateFirewallRuleGroups(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		associationList, err := client.ListFirewallRuleGroupAssociations(&route53resolver.ListFirewallRuleGroupAssociationsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list firewall rule group associations")
+			return err
+		}
+
+		for _, association := range associationList.FirewallRuleGroupAssociations {
+			if association.ManagedOwnerName != nil || aws.StringValue(association.Status) == route53resolver.FirewallRuleGroupAssociationStatusDeleting {
+				continue
+			}
+			if aws.StringValue(association.MutationProtection) == route53resolver.MutationProtectionStatusEnabled {
+				_, err = client.UpdateFirewallRuleGroupAssociation(&route53resolver.UpdateFirewallRuleGroupAssociationInput{
+					FirewallRuleGroupAssociationId: association.Id,
+					MutationProtection:             aws.String(route53resolver.MutationProtectionStatusDisabled),
+				})
+				if err != nil {
+					logger.Error(err, "Failed to disable mutation protection of firewall rule group association", "ID", aws.StringValue(association.Id))
+					errFlag = true
+					continue
+				}
+			}
+			_, err = client.DisassociateFirewallRuleGroup(&route53resolver.DisassociateFirewallRuleGroupInput{FirewallRuleGroupAssociationId: association.Id})
+			if err != nil {
+				logger.Error(err, "Failed to disassociate firewall rule group", "RuleGroupID", aws.StringValue(association.FirewallRuleGroupId), "VpcID", aws.StringValue(association.VpcId))
+				errFlag = true
+			}
+		}
+
+		if associationList.NextToken != nil {
+			token = associationList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveFirewallRuleGroupDisassociation")
+	}
+	return nil
+}
+
+// ListFirewallRuleGroupsForDeletion returns the IDs of the DNS Firewall rule groups owned by the account
+// rule groups shared by other accounts can not be deleted from here
+func ListFirewallRuleGroupsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var ruleGroupsToBeDeleted []*string
+	var token *string
+	for {
+		ruleGroupList, err := client.ListFirewallRuleGroups(&route53resolver.ListFirewallRuleGroupsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list firewall rule groups")
+			return nil, err
+		}
+
+		for _, ruleGroup := range ruleGroupList.FirewallRuleGroups {
+			if aws.StringValue(ruleGroup.ShareStatus) == route53resolver.ShareStatusSharedWithMe {
+				continue
+			}
+			ruleGroupsToBeDeleted = append(ruleGroupsToBeDeleted, ruleGroup.Id)
+		}
+
+		if ruleGroupList.NextToken != nil {
+			token = ruleGroupList.NextToken
+		} else {
+			break
+		}
+	}
+	return ruleGroupsToBeDeleted, nil
+}
+
+// DeleteFirewallRuleGroups deletes the given DNS Firewall rule groups along with their rules
+func DeleteFirewallRuleGroups(client clientpkg.Client, ruleGroupsToBeDeleted []*string, logger logr.Logger) error {
+
+	if ruleGroupsToBeDeleted == nil {
+		return nil
+	}
+	var ruleGroupsNotDeleted []*string
+	for _, ruleGroupID := range ruleGroupsToBeDeleted {
+		_, err := client.DeleteFirewallRuleGroup(&route53resolver.DeleteFirewallRuleGroupInput{FirewallRuleGroupId: ruleGroupID})
+		if err != nil {
+			logger.Error(err, "Failed to delete firewall rule group", "ID", *ruleGroupID)
+			ruleGroupsNotDeleted = append(ruleGroupsNotDeleted, ruleGroupID)
+			localMetrics.ResourceFail(localMetrics.DNSFirewallGroup, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.DNSFirewallGroup, client.GetRegion())
+	}
+
+	if ruleGroupsNotDeleted != nil {
+		return errors.New("FailedComprehensiveFirewallRuleGroupDeletion")
+	}
+	return nil
+}
+
+// CleanRoute53Resolver disassociates and deletes resolver rules, query logging configs and DNS Firewall rule groups, then deletes resolver endpoints
+// endpoints own network interfaces in the VPC subnets and associations keep the VPC from being deleted, so this has to run before CleanVpcInstances
+func CleanRoute53Resolver(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+
+	if err := DisassociateResolverRules(client, logger); err != nil {
+		errFlag = true
+	}
+
+	// forwarding rules reference an outbound endpoint, which can not be deleted before them
+	rulesToBeDeleted, err := ListResolverRulesForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteResolverRules(client, rulesToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete resolver rules")
+		errFlag = true
+	}
+
+	if err := DisassociateResolverQueryLogConfigs(client, logger); err != nil {
+		errFlag = true
+	}
+
+	configsToBeDeleted, err := ListResolverQueryLogConfigsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteResolverQueryLogConfigs(client, configsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete resolver query logging configs")
+		errFlag = true
+	}
+
+	if err := DisassociateFirewallRuleGroups(client, logger); err != nil {
+		errFlag = true
+	}
+
+	ruleGroupsToBeDeleted, err := ListFirewallRuleGroupsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteFirewallRuleGroups(client, ruleGroupsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete firewall rule groups")
+		errFlag = true
+	}
+
+	endpointsToBeDeleted, err := ListResolverEndpointsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteResolverEndpoints(client, endpointsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete resolver endpoints")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanRoute53Resolver")
+	}
+	logger.Info("All Route53 Resolver rules, query logging configs, DNS Firewall rule groups and endpoints have been deleted for this region")
+	return nil
+}
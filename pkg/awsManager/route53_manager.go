@@ -10,6 +10,13 @@ import (
 	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
 )
 
+// DNSSEC statuses, which the SDK has no constants for
+const (
+	dnssecSigning = "SIGNING"
+	kskActive     = "ACTIVE"
+	kskDeleting   = "DELETING"
+)
+
 // source : https://github.com/openshift/aws-account-operator/blob/master/pkg/controller/accountclaim/reuse.go#L321
 // CleanUpAwsRoute53 cleans up awsRoute53
 func CleanUpAwsRoute53(client clientpkg.Client, logger logr.Logger) error {
@@ -17,6 +24,11 @@ func CleanUpAwsRoute53(client clientpkg.Client, logger logr.Logger) error {
 	var nextZoneMarker *string
 	var errFlag bool = false
 
+	// records created by a traffic policy instance can only be deleted along with the instance
+	if err := DeleteTrafficPolicyInstances(client, logger); err != nil {
+		errFlag = true
+	}
+
 	// Paginate through hosted zones
 	for {
 		// Get list of hosted zones by page
@@ -29,6 +41,11 @@ func CleanUpAwsRoute53(client clientpkg.Client, logger logr.Logger) error {
 
 		for _, zone := range hostedZonesOutput.HostedZones {
 
+			// remove what keeps DeleteHostedZone from succeeding besides the record sets
+			if err := PrepareHostedZoneForDeletion(client, zone, logger); err != nil {
+				errFlag = true
+			}
+
 			// List and delete all Record Sets for the current zone
 			var nextRecordName *string
 			// Pagination again!!!!!
@@ -37,30 +54,19 @@ func CleanUpAwsRoute53(client clientpkg.Client, logger logr.Logger) error {
 				if listRecordsError != nil {
 					logger.Error(listRecordsError, "Failed to list Record sets for hosted zone", "Name", *zone.Name)
 					errFlag = true
+					break
 				}
 
-				changeBatch := &route53.ChangeBatch{}
+				var recordsToBeDeleted []*route53.ResourceRecordSet
 				for _, record := range recordSet.ResourceRecordSets {
-					// Build ChangeBatch
-					// https://docs.aws.amazon.com/sdk-for-go/api/service/route53/#ChangeBatch
-					//https://docs.aws.amazon.com/sdk-for-go/api/service/route53/#Change
+					// the NS and SOA records at the zone apex go with the zone
 					if *record.Type != "NS" && *record.Type != "SOA" {
-						changeBatch.Changes = append(changeBatch.Changes, &route53.Change{
-							Action:            aws.String("DELETE"),
-							ResourceRecordSet: record,
-						})
+						recordsToBeDeleted = append(recordsToBeDeleted, record)
 					}
 				}
 
-				if changeBatch.Changes != nil {
-					_, changeErr := client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{HostedZoneId: zone.Id, ChangeBatch: changeBatch})
-					if changeErr != nil {
-						logger.Error(changeErr, "Failed to delete record sets for hosted zone", "Name", *zone.Name)
-						errFlag = true
-						localMetrics.ResourceFail(localMetrics.Route53RecordSet, client.GetRegion())
-					} else {
-						localMetrics.ResourceSuccess(localMetrics.Route53RecordSet, client.GetRegion())
-					}
+				if err := DeleteResourceRecordSets(client, zone, recordsToBeDeleted, logger); err != nil {
+					errFlag = true
 				}
 
 				if *recordSet.IsTruncated {
@@ -88,6 +94,11 @@ func CleanUpAwsRoute53(client clientpkg.Client, logger logr.Logger) error {
 		}
 	}
 
+	// health checks may be referenced by the record sets deleted above
+	if err := DeleteHealthChecks(client, logger); err != nil {
+		errFlag = true
+	}
+
 	// errFlag initially set to false
 	if errFlag {
 		return errors.New("ERROR")
@@ -96,3 +107,327 @@ func CleanUpAwsRoute53(client clientpkg.Client, logger logr.Logger) error {
 		return nil
 	}
 }
+
+// ChangeResourceRecordSets limits, see https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets
+const (
+	// route53MaxBatchRecords is the maximum number of ResourceRecord elements in a single request
+	route53MaxBatchRecords = 1000
+	// route53MaxBatchValueChars is the maximum number of characters across all Value elements of a single request
+	route53MaxBatchValueChars = 32000
+)
+
+// recordSetBatchSize returns how much of the ChangeResourceRecordSets limits deleting the record set takes up
+// alias records carry no ResourceRecord, they are counted as one element
+func recordSetBatchSize(record *route53.ResourceRecordSet) (records int, valueChars int) {
+	for _, resourceRecord := range record.ResourceRecords {
+		valueChars += len(aws.StringValue(resourceRecord.Value))
+	}
+	records = len(record.ResourceRecords)
+	if records == 0 {
+		records = 1
+	}
+	return records, valueChars
+}
+
+// batchRecordSetDeletions splits the deletion of the given record sets into change batches within the ChangeResourceRecordSets limits
+func batchRecordSetDeletions(records []*route53.ResourceRecordSet) []*route53.ChangeBatch {
+
+	var batches []*route53.ChangeBatch
+	var batch *route53.ChangeBatch
+	batchRecords, batchValueChars := 0, 0
+	for _, record := range records {
+		recordCount, valueChars := recordSetBatchSize(record)
+		if batch == nil || batchRecords+recordCount > route53MaxBatchRecords || batchValueChars+valueChars > route53MaxBatchValueChars {
+			batch = &route53.ChangeBatch{}
+			batches = append(batches, batch)
+			batchRecords, batchValueChars = 0, 0
+		}
+		batch.Changes = append(batch.Changes, &route53.Change{Action: aws.String(route53.ChangeActionDelete), ResourceRecordSet: record})
+		batchRecords += recordCount
+		batchValueChars += valueChars
+	}
+	return batches
+}
+
+// DeleteResourceRecordSets deletes the given record sets of the zone in as few calls as the API limits allow
+// a rejected batch fails as a whole, so its record sets are retried one by one to delete all but the offending ones
+func DeleteResourceRecordSets(client clientpkg.Client, zone *route53.HostedZone, records []*route53.ResourceRecordSet, logger logr.Logger) error {
+
+	errFlag := false
+	for _, batch := range batchRecordSetDeletions(records) {
+		_, err := client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{HostedZoneId: zone.Id, ChangeBatch: batch})
+		if err == nil {
+			for range batch.Changes {
+				localMetrics.ResourceSuccess(localMetrics.Route53RecordSet, client.GetRegion())
+			}
+			continue
+		}
+		logger.Error(err, "Failed to delete record sets for hosted zone, retrying them one by one", "Name", *zone.Name)
+
+		for _, change := range batch.Changes {
+			_, err = client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{HostedZoneId: zone.Id, ChangeBatch: &route53.ChangeBatch{Changes: []*route53.Change{change}}})
+			if err != nil {
+				logger.Error(err, "Failed to delete record set", "Name", *zone.Name, "Record", aws.StringValue(change.ResourceRecordSet.Name), "Type", aws.StringValue(change.ResourceRecordSet.Type))
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.Route53RecordSet, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.Route53RecordSet, client.GetRegion())
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveRecordSetDeletion")
+	}
+	return nil
+}
+
+// PrepareHostedZoneForDeletion deletes the query logging configuration of the zone and, for private zones,
+// the VPC association authorizations and all VPC associations but the last one, which goes with the zone
+// public zones also get their DNSSEC signing disabled and their key signing keys deleted
+func PrepareHostedZoneForDeletion(client clientpkg.Client, zone *route53.HostedZone, logger logr.Logger) error {
+
+	errFlag := false
+
+	if err := DeleteQueryLoggingConfigs(client, zone.Id, logger); err != nil {
+		errFlag = true
+	}
+
+	if zone.Config == nil || !aws.BoolValue(zone.Config.PrivateZone) {
+		if err := DisableHostedZoneDNSSEC(client, zone.Id, logger); err != nil {
+			errFlag = true
+		}
+	}
+
+	if zone.Config != nil && aws.BoolValue(zone.Config.PrivateZone) {
+		if err := DeleteVPCAssociationAuthorizations(client, zone.Id, logger); err != nil {
+			errFlag = true
+		}
+		if err := DisassociateExtraHostedZoneVPCs(client, zone.Id, logger); err != nil {
+			errFlag = true
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedToPrepareHostedZoneForDeletion")
+	}
+	return nil
+}
+
+// DisableHostedZoneDNSSEC disables the DNSSEC signing of the hosted zone, then deactivates and deletes its key signing keys
+// a zone with key signing keys can not be deleted, and a key signing key can only be deleted once inactive
+// signing can not be disabled while the parent zone still holds a DS record for the zone, which has to be removed by hand
+func DisableHostedZoneDNSSEC(client clientpkg.Client, zoneID *string, logger logr.Logger) error {
+
+	dnssec, err := client.GetDNSSEC(&route53.GetDNSSECInput{HostedZoneId: zoneID})
+	if err != nil {
+		logger.Error(err, "Failed to get DNSSEC status", "ZoneID", *zoneID)
+		return err
+	}
+
+	if dnssec.Status != nil && aws.StringValue(dnssec.Status.ServeSignature) == dnssecSigning {
+		_, err = client.DisableHostedZoneDNSSEC(&route53.DisableHostedZoneDNSSECInput{HostedZoneId: zoneID})
+		if err != nil {
+			logger.Error(err, "Failed to disable DNSSEC signing", "ZoneID", *zoneID)
+			return err
+		}
+	}
+
+	errFlag := false
+	for _, key := range dnssec.KeySigningKeys {
+		switch aws.StringValue(key.Status) {
+		case kskDeleting:
+			continue
+		case kskActive:
+			_, err = client.DeactivateKeySigningKey(&route53.DeactivateKeySigningKeyInput{HostedZoneId: zoneID, Name: key.Name})
+			if err != nil {
+				logger.Error(err, "Failed to deactivate key signing key", "ZoneID", *zoneID, "Name", aws.StringValue(key.Name))
+				errFlag = true
+				continue
+			}
+		}
+
+		_, err = client.DeleteKeySigningKey(&route53.DeleteKeySigningKeyInput{HostedZoneId: zoneID, Name: key.Name})
+		if err != nil {
+			logger.Error(err, "Failed to delete key signing key", "ZoneID", *zoneID, "Name", aws.StringValue(key.Name))
+			errFlag = true
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveKeySigningKeyDeletion")
+	}
+	return nil
+}
+
+// DeleteQueryLoggingConfigs deletes the query logging configurations of the hosted zone
+func DeleteQueryLoggingConfigs(client clientpkg.Client, zoneID *string, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		configList, err := client.ListQueryLoggingConfigs(&route53.ListQueryLoggingConfigsInput{HostedZoneId: zoneID, NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list query logging configs", "ZoneID", *zoneID)
+			return err
+		}
+
+		for _, config := range configList.QueryLoggingConfigs {
+			_, err = client.DeleteQueryLoggingConfig(&route53.DeleteQueryLoggingConfigInput{Id: config.Id})
+			if err != nil {
+				logger.Error(err, "Failed to delete query logging config", "ZoneID", *zoneID, "ID", aws.StringValue(config.Id))
+				errFlag = true
+			}
+		}
+
+		if configList.NextToken != nil {
+			token = configList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveQueryLoggingConfigDeletion")
+	}
+	return nil
+}
+
+// DeleteVPCAssociationAuthorizations revokes the authorizations that let other accounts associate their VPCs with the private zone
+func DeleteVPCAssociationAuthorizations(client clientpkg.Client, zoneID *string, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		authorizationList, err := client.ListVPCAssociationAuthorizations(&route53.ListVPCAssociationAuthorizationsInput{HostedZoneId: zoneID, NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list VPC association authorizations", "ZoneID", *zoneID)
+			return err
+		}
+
+		for _, vpc := range authorizationList.VPCs {
+			_, err = client.DeleteVPCAssociationAuthorization(&route53.DeleteVPCAssociationAuthorizationInput{HostedZoneId: zoneID, VPC: vpc})
+			if err != nil {
+				logger.Error(err, "Failed to delete VPC association authorization", "ZoneID", *zoneID, "VpcID", aws.StringValue(vpc.VPCId))
+				errFlag = true
+			}
+		}
+
+		if authorizationList.NextToken != nil {
+			token = authorizationList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveVPCAssociationAuthorizationDeletion")
+	}
+	return nil
+}
+
+// DisassociateExtraHostedZoneVPCs disassociates every VPC but the last one from the private zone
+// VPCs of other accounts keep their association until the zone is deleted otherwise, and a private zone always needs one VPC
+func DisassociateExtraHostedZoneVPCs(client clientpkg.Client, zoneID *string, logger logr.Logger) error {
+
+	hostedZone, err := client.GetHostedZone(&route53.GetHostedZoneInput{Id: zoneID})
+	if err != nil {
+		logger.Error(err, "Failed to get hosted zone", "ZoneID", *zoneID)
+		return err
+	}
+
+	errFlag := false
+	for i := 1; i < len(hostedZone.VPCs); i++ {
+		vpc := hostedZone.VPCs[i]
+		_, err = client.DisassociateVPCFromHostedZone(&route53.DisassociateVPCFromHostedZoneInput{HostedZoneId: zoneID, VPC: vpc})
+		if err != nil {
+			logger.Error(err, "Failed to disassociate VPC from hosted zone", "ZoneID", *zoneID, "VpcID", aws.StringValue(vpc.VPCId))
+			errFlag = true
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveHostedZoneVPCDisassociation")
+	}
+	return nil
+}
+
+// DeleteTrafficPolicyInstances deletes every traffic policy instance of the account, along with the records they created
+func DeleteTrafficPolicyInstances(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+	input := &route53.ListTrafficPolicyInstancesInput{}
+	for {
+		instanceList, err := client.ListTrafficPolicyInstances(input)
+		if err != nil {
+			logger.Error(err, "Failed to list traffic policy instances")
+			return err
+		}
+
+		for _, instance := range instanceList.TrafficPolicyInstances {
+			_, err = client.DeleteTrafficPolicyInstance(&route53.DeleteTrafficPolicyInstanceInput{Id: instance.Id})
+			if err != nil {
+				logger.Error(err, "Failed to delete traffic policy instance", "ID", aws.StringValue(instance.Id))
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.TrafficPolicyInst, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.TrafficPolicyInst, client.GetRegion())
+		}
+
+		if aws.BoolValue(instanceList.IsTruncated) {
+			input = &route53.ListTrafficPolicyInstancesInput{
+				HostedZoneIdMarker:              instanceList.HostedZoneIdMarker,
+				TrafficPolicyInstanceNameMarker: instanceList.TrafficPolicyInstanceNameMarker,
+				TrafficPolicyInstanceTypeMarker: instanceList.TrafficPolicyInstanceTypeMarker,
+			}
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveTrafficPolicyInstanceDeletion")
+	}
+	return nil
+}
+
+// DeleteHealthChecks deletes the health checks of the account
+// health checks created by other services, such as Cloud Map, are managed by that service and are skipped
+func DeleteHealthChecks(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+	var marker *string
+	for {
+		healthCheckList, err := client.ListHealthChecks(&route53.ListHealthChecksInput{Marker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list health checks")
+			return err
+		}
+
+		for _, healthCheck := range healthCheckList.HealthChecks {
+			if healthCheck.LinkedService != nil {
+				continue
+			}
+			_, err = client.DeleteHealthCheck(&route53.DeleteHealthCheckInput{HealthCheckId: healthCheck.Id})
+			if err != nil {
+				logger.Error(err, "Failed to delete health check", "ID", aws.StringValue(healthCheck.Id))
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.Route53HealthCheck, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.Route53HealthCheck, client.GetRegion())
+		}
+
+		if aws.BoolValue(healthCheckList.IsTruncated) {
+			marker = healthCheckList.NextMarker
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveHealthCheckDeletion")
+	}
+	return nil
+}
@@ -1,43 +1,123 @@
 package awsManager
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/go-logr/logr"
 	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
 	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
 )
 
 //ListS3InstancesForDeletion creates a string list of s3 resources that need to be deleted
-func ListS3InstancesForDeletion(client clientpkg.Client, logger logr.Logger) []*string {
+func ListS3InstancesForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
 
 	var s3BucketsToBeDeleted []*string
 	s3bucketDescription, err := client.ListBuckets(&s3.ListBucketsInput{})
 	if err != nil {
 		logger.Error(err, "Failed to list s3 buckets")
+		return nil, err
 	}
 	for _, bucket := range s3bucketDescription.Buckets {
 		s3BucketsToBeDeleted = append(s3BucketsToBeDeleted, bucket.Name)
 	}
 
-	return s3BucketsToBeDeleted
+	return s3BucketsToBeDeleted, nil
 }
 
-//DeleteS3Buckets deletes the S3 buckets
+// RegionalClientFunc returns a client for the given region, with the credentials of the account being cleaned
+type RegionalClientFunc func(region string) (clientpkg.Client, error)
+
+// GetS3BucketRegion returns the region the bucket lives in
+func GetS3BucketRegion(client clientpkg.Client, bucket *string, logger logr.Logger) (string, error) {
+
+	location, err := client.GetBucketLocation(&s3.GetBucketLocationInput{Bucket: bucket})
+	if err != nil {
+		logger.Error(err, "Failed to get bucket location", "Bucket", *bucket)
+		return "", err
+	}
+	// us-east-1 buckets report an empty location, and the oldest eu-west-1 buckets report "EU"
+	return s3.NormalizeBucketLocation(aws.StringValue(location.LocationConstraint)), nil
+}
+
+// GroupS3BucketsByRegion returns the given buckets keyed by the region they live in
+// buckets whose region can not be resolved are left out, and reported through the returned error
+func GroupS3BucketsByRegion(client clientpkg.Client, s3Buckets []*string, logger logr.Logger) (map[string][]*string, error) {
+
+	bucketsByRegion := map[string][]*string{}
+	var bucketsNotResolved []*string
+	for _, bucket := range s3Buckets {
+		region, err := GetS3BucketRegion(client, bucket, logger)
+		if err != nil {
+			bucketsNotResolved = append(bucketsNotResolved, bucket)
+			localMetrics.ResourceFail(localMetrics.S3Bucket, client.GetRegion())
+			continue
+		}
+		bucketsByRegion[region] = append(bucketsByRegion[region], bucket)
+	}
+
+	if bucketsNotResolved != nil {
+		return bucketsByRegion, errors.New("FailedComprehensiveS3BucketLocation")
+	}
+	return bucketsByRegion, nil
+}
+
+//DeleteS3Buckets deletes the S3 buckets, which all have to live in the region of the client
 // successful execution returns nil. Unsuccessful execution or errors occurred, would return an error
+// when the only buckets left are under compliance retention, the *S3RetentionError ending last is returned
 func DeleteS3Buckets(client clientpkg.Client, s3BucketsToBeDeleted []*string, logger logr.Logger) error {
+	if s3BucketsToBeDeleted == nil {
+		return nil
+	}
+	return deleteS3Buckets(client, s3BucketsToBeDeleted, client.GetRegion(), logger)
+}
+
+// deleteS3Buckets is DeleteS3Buckets reporting its metrics under the given region label
+// rather than under the region of the client the buckets are deleted through
+func deleteS3Buckets(client clientpkg.Client, s3BucketsToBeDeleted []*string, metricsRegion string, logger logr.Logger) error {
 
 	if s3BucketsToBeDeleted == nil {
 		return nil
 	}
+
+	// access points are listed per account, a failure only leaves them to block their bucket below
+	var accountID *string
+	identity, err := client.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		logger.Error(err, "Failed to get the account ID, S3 access points are not deleted")
+	} else {
+		accountID = identity.Account
+	}
+
 	var s3BucketsNotDeleted []*string
+	var retentionErr *S3RetentionError
 	for _, bucket := range s3BucketsToBeDeleted {
 
+		// configurations that keep writing to or referencing the bucket go first
+		configurationError := DeleteS3BucketConfigurations(client, bucket, accountID, logger)
+		if configurationError != nil {
+			logger.Error(configurationError, "Failed to remove bucket configurations", "Bucket", *bucket)
+		}
+
 		// need to empty the bucket before the bucket can be deleted
-		batchDeleteError := client.BatchDeleteBucketObjects(bucket)
-		if batchDeleteError != nil {
-			logger.Error(batchDeleteError, "Failed to empty bucket", *bucket)
+		emptyError := EmptyS3Bucket(client, bucket, logger)
+		var bucketRetentionErr *S3RetentionError
+		if errors.As(emptyError, &bucketRetentionErr) {
+			// not a failure of the shredder, the bucket can only go once its retention ends
+			logger.Info("Bucket holds objects under compliance retention and is unshreddable until the retention ends", "Bucket", *bucket, "RetainUntil", bucketRetentionErr.RetainUntil)
+			retentionErr = laterRetention(retentionErr, bucketRetentionErr)
+			localMetrics.ResourceLocked(localMetrics.S3Bucket, metricsRegion)
+			continue
+		}
+		if emptyError != nil {
+			logger.Error(emptyError, "Failed to empty bucket", "Bucket", *bucket)
 		}
 
 		// Deleting the bucket
@@ -45,27 +125,466 @@ func DeleteS3Buckets(client clientpkg.Client, s3BucketsToBeDeleted []*string, lo
 		if err != nil {
 			logger.Error(err, "could not delete bucket", *bucket)
 			s3BucketsNotDeleted = append(s3BucketsNotDeleted, bucket)
-			localMetrics.ResourceFail(localMetrics.S3Bucket, client.GetRegion())
+			localMetrics.ResourceFail(localMetrics.S3Bucket, metricsRegion)
 			continue
 		}
-		localMetrics.ResourceSuccess(localMetrics.S3Bucket, client.GetRegion())
+		localMetrics.ResourceSuccess(localMetrics.S3Bucket, metricsRegion)
 	}
 
 	if s3BucketsNotDeleted != nil {
 		return errors.New("s3BucketsNotDeleted")
 	}
+	if retentionErr != nil {
+		return retentionErr
+	}
+
+	return nil
+}
+
+// DeleteS3BucketConfigurations removes the replication, lifecycle and inventory configurations of the bucket and its access points
+// access points are skipped when accountID is nil
+func DeleteS3BucketConfigurations(client clientpkg.Client, bucket *string, accountID *string, logger logr.Logger) error {
+
+	errFlag := false
+
+	// deleting a configuration that does not exist succeeds
+	_, err := client.DeleteBucketReplication(&s3.DeleteBucketReplicationInput{Bucket: bucket})
+	if err != nil {
+		logger.Error(err, "Failed to delete bucket replication configuration", "Bucket", *bucket)
+		errFlag = true
+	}
+
+	_, err = client.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{Bucket: bucket})
+	if err != nil {
+		logger.Error(err, "Failed to delete bucket lifecycle configuration", "Bucket", *bucket)
+		errFlag = true
+	}
+
+	if err = DeleteS3BucketInventoryConfigurations(client, bucket, logger); err != nil {
+		errFlag = true
+	}
+
+	if accountID != nil {
+		if err = DeleteS3AccessPoints(client, bucket, accountID, logger); err != nil {
+			errFlag = true
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedToDeleteS3BucketConfigurations")
+	}
+	return nil
+}
+
+// DeleteS3BucketInventoryConfigurations deletes the inventory configurations of the bucket
+func DeleteS3BucketInventoryConfigurations(client clientpkg.Client, bucket *string, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		inventoryList, err := client.ListBucketInventoryConfigurations(&s3.ListBucketInventoryConfigurationsInput{Bucket: bucket, ContinuationToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list bucket inventory configurations", "Bucket", *bucket)
+			return err
+		}
+
+		for _, inventory := range inventoryList.InventoryConfigurationList {
+			_, err = client.DeleteBucketInventoryConfiguration(&s3.DeleteBucketInventoryConfigurationInput{Bucket: bucket, Id: inventory.Id})
+			if err != nil {
+				logger.Error(err, "Failed to delete bucket inventory configuration", "Bucket", *bucket, "ID", aws.StringValue(inventory.Id))
+				errFlag = true
+			}
+		}
+
+		if aws.BoolValue(inventoryList.IsTruncated) {
+			token = inventoryList.NextContinuationToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveS3InventoryDeletion")
+	}
+	return nil
+}
+
+// DeleteS3AccessPoints deletes the access points of the bucket, a bucket with access points can not be deleted
+func DeleteS3AccessPoints(client clientpkg.Client, bucket *string, accountID *string, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		accessPointList, err := client.ListAccessPoints(&s3control.ListAccessPointsInput{AccountId: accountID, Bucket: bucket, NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list access points", "Bucket", *bucket)
+			return err
+		}
+
+		for _, accessPoint := range accessPointList.AccessPointList {
+			_, err = client.DeleteAccessPoint(&s3control.DeleteAccessPointInput{AccountId: accountID, Name: accessPoint.Name})
+			if err != nil {
+				logger.Error(err, "Failed to delete access point", "Bucket", *bucket, "Name", aws.StringValue(accessPoint.Name))
+				errFlag = true
+			}
+		}
+
+		if accessPointList.NextToken != nil {
+			token = accessPointList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveS3AccessPointDeletion")
+	}
+	return nil
+}
+
+// S3RetentionError indicates a bucket holds object versions under compliance-mode retention
+// such versions can not be deleted by anyone, including the root user, so the bucket is unshreddable until RetainUntil
+type S3RetentionError struct {
+	Bucket      string
+	RetainUntil time.Time
+}
+
+func (e *S3RetentionError) Error() string {
+	return fmt.Sprintf("S3BucketUnshreddableUntil %s: %s", e.RetainUntil.Format(time.RFC3339), e.Bucket)
+}
+
+// laterRetention returns whichever of the two retention errors ends last, either may be nil
+func laterRetention(a, b *S3RetentionError) *S3RetentionError {
+	if a == nil || (b != nil && b.RetainUntil.After(a.RetainUntil)) {
+		return b
+	}
+	return a
+}
+
+// IsObjectLockEnabled reports whether Object Lock is enabled on the bucket
+func IsObjectLockEnabled(client clientpkg.Client, bucket *string, logger logr.Logger) (bool, error) {
+
+	lockConfiguration, err := client.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{Bucket: bucket})
+	if err != nil {
+		// buckets created without Object Lock return ObjectLockConfigurationNotFoundError
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "ObjectLockConfigurationNotFoundError" {
+			return false, nil
+		}
+		logger.Error(err, "Failed to get Object Lock configuration", "Bucket", *bucket)
+		return false, err
+	}
+	return lockConfiguration.ObjectLockConfiguration != nil &&
+		aws.StringValue(lockConfiguration.ObjectLockConfiguration.ObjectLockEnabled) == s3.ObjectLockEnabledEnabled, nil
+}
+
+// releaseObjectVersion removes the legal hold of an object version in an Object Lock bucket
+// and returns the end of its retention when it is under compliance mode, or nil when it can be deleted
+func releaseObjectVersion(client clientpkg.Client, bucket *string, version *s3.ObjectVersion, logger logr.Logger) *time.Time {
+
+	// switching a legal hold off is harmless when the version has none
+	_, err := client.PutObjectLegalHold(&s3.PutObjectLegalHoldInput{
+		Bucket:    bucket,
+		Key:       version.Key,
+		VersionId: version.VersionId,
+		LegalHold: &s3.ObjectLockLegalHold{Status: aws.String(s3.ObjectLockLegalHoldStatusOff)},
+	})
+	if err != nil {
+		logger.Error(err, "Failed to remove legal hold", "Bucket", *bucket, "Key", aws.StringValue(version.Key), "VersionID", aws.StringValue(version.VersionId))
+	}
+
+	// versions without retention return NoSuchObjectLockConfiguration, governance retention is bypassed on deletion
+	retention, err := client.GetObjectRetention(&s3.GetObjectRetentionInput{Bucket: bucket, Key: version.Key, VersionId: version.VersionId})
+	if err != nil || retention.Retention == nil {
+		return nil
+	}
+	if aws.StringValue(retention.Retention.Mode) != s3.ObjectLockRetentionModeCompliance || !aws.TimeValue(retention.Retention.RetainUntilDate).After(time.Now()) {
+		return nil
+	}
+	return retention.Retention.RetainUntilDate
+}
+
+// s3DeleteObjectsBatchSize is the maximum number of keys a single DeleteObjects call accepts
+const s3DeleteObjectsBatchSize = 1000
+
+// EmptyS3Bucket removes everything that keeps a bucket from being deleted: policies denying deletion,
+// in-progress multipart uploads, and every object version and delete marker
+// an *S3RetentionError is returned when the only versions left are under compliance-mode retention
+func EmptyS3Bucket(client clientpkg.Client, bucket *string, logger logr.Logger) error {
+
+	errFlag := false
+
+	if err := RemoveDenyingBucketPolicy(client, bucket, logger); err != nil {
+		errFlag = true
+	}
+
+	objectLockEnabled, err := IsObjectLockEnabled(client, bucket, logger)
+	if err != nil {
+		errFlag = true
+	}
+
+	// S3 refuses to suspend versioning on Object Lock buckets
+	if !objectLockEnabled {
+		if err := SuspendBucketVersioning(client, bucket, logger); err != nil {
+			errFlag = true
+		}
+	}
+
+	if err := AbortMultipartUploads(client, bucket, logger); err != nil {
+		errFlag = true
+	}
+
+	var retentionErr *S3RetentionError
+	if err = DeleteObjectVersions(client, bucket, objectLockEnabled, logger); err != nil && !errors.As(err, &retentionErr) {
+		errFlag = true
+	}
 
+	if errFlag {
+		return errors.New("FailedToEmptyS3Bucket")
+	}
+	// the bucket is otherwise empty, only versions under compliance retention are left
+	if retentionErr != nil {
+		return retentionErr
+	}
+	return nil
+}
+
+// bucketPolicy holds the parts of a bucket policy needed to find deny statements
+// Statement may be a single statement or a list of them
+type bucketPolicy struct {
+	Statement json.RawMessage
+}
+
+// bucketPolicyStatement holds the effect of a bucket policy statement
+type bucketPolicyStatement struct {
+	Effect string
+}
+
+// policyHasDenyStatement reports whether the bucket policy document contains a Deny statement
+// unreadable documents are treated as denying, removing the policy is what lets the bucket go either way
+func policyHasDenyStatement(policy string) bool {
+
+	var document bucketPolicy
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return true
+	}
+
+	var statements []bucketPolicyStatement
+	if err := json.Unmarshal(document.Statement, &statements); err != nil {
+		var statement bucketPolicyStatement
+		if err := json.Unmarshal(document.Statement, &statement); err != nil {
+			return true
+		}
+		statements = append(statements, statement)
+	}
+
+	for _, statement := range statements {
+		if statement.Effect == "Deny" {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveDenyingBucketPolicy deletes the bucket policy when it contains a Deny statement
+// such policies commonly block s3:DeleteObject or s3:DeleteBucket, even for the account owner's role
+func RemoveDenyingBucketPolicy(client clientpkg.Client, bucket *string, logger logr.Logger) error {
+
+	policy, err := client.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: bucket})
+	if err != nil {
+		// buckets without a policy return NoSuchBucketPolicy
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NoSuchBucketPolicy" {
+			return nil
+		}
+		logger.Error(err, "Failed to get bucket policy", "Bucket", *bucket)
+		return err
+	}
+
+	if !policyHasDenyStatement(aws.StringValue(policy.Policy)) {
+		return nil
+	}
+
+	_, err = client.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{Bucket: bucket})
+	if err != nil {
+		logger.Error(err, "Failed to delete bucket policy", "Bucket", *bucket)
+		return err
+	}
+	return nil
+}
+
+// SuspendBucketVersioning suspends versioning on the bucket, so deleting objects no longer creates delete markers
+func SuspendBucketVersioning(client clientpkg.Client, bucket *string, logger logr.Logger) error {
+
+	versioning, err := client.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: bucket})
+	if err != nil {
+		logger.Error(err, "Failed to get bucket versioning", "Bucket", *bucket)
+		return err
+	}
+	if aws.StringValue(versioning.Status) != s3.BucketVersioningStatusEnabled {
+		return nil
+	}
+
+	_, err = client.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket:                  bucket,
+		VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(s3.BucketVersioningStatusSuspended)},
+	})
+	if err != nil {
+		logger.Error(err, "Failed to suspend bucket versioning", "Bucket", *bucket)
+		return err
+	}
+	return nil
+}
+
+// AbortMultipartUploads aborts the in-progress multipart uploads of the bucket, whose parts are not listed as objects
+func AbortMultipartUploads(client clientpkg.Client, bucket *string, logger logr.Logger) error {
+
+	errFlag := false
+	var keyMarker, uploadIDMarker *string
+	for {
+		uploadList, err := client.ListMultipartUploads(&s3.ListMultipartUploadsInput{Bucket: bucket, KeyMarker: keyMarker, UploadIdMarker: uploadIDMarker})
+		if err != nil {
+			logger.Error(err, "Failed to list multipart uploads", "Bucket", *bucket)
+			return err
+		}
+
+		for _, upload := range uploadList.Uploads {
+			_, err = client.AbortMultipartUpload(&s3.AbortMultipartUploadInput{Bucket: bucket, Key: upload.Key, UploadId: upload.UploadId})
+			if err != nil {
+				logger.Error(err, "Failed to abort multipart upload", "Bucket", *bucket, "Key", aws.StringValue(upload.Key))
+				errFlag = true
+			}
+		}
+
+		if aws.BoolValue(uploadList.IsTruncated) {
+			keyMarker = uploadList.NextKeyMarker
+			uploadIDMarker = uploadList.NextUploadIdMarker
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveMultipartUploadAbort")
+	}
+	return nil
+}
+
+// DeleteObjectVersions deletes every object version and delete marker of the bucket
+// unversioned objects are listed with the version ID "null", so this empties unversioned buckets as well
+// in Object Lock buckets legal holds are removed and governance retention is bypassed, versions under compliance
+// retention are left in place and reported through an *S3RetentionError
+func DeleteObjectVersions(client clientpkg.Client, bucket *string, objectLockEnabled bool, logger logr.Logger) error {
+
+	errFlag := false
+	var retentionErr *S3RetentionError
+	var keyMarker, versionIDMarker *string
+	for {
+		versionList, err := client.ListObjectVersions(&s3.ListObjectVersionsInput{
+			Bucket:          bucket,
+			KeyMarker:       keyMarker,
+			VersionIdMarker: versionIDMarker,
+			MaxKeys:         aws.Int64(s3DeleteObjectsBatchSize),
+		})
+		if err != nil {
+			logger.Error(err, "Failed to list object versions", "Bucket", *bucket)
+			return err
+		}
+
+		var objects []*s3.ObjectIdentifier
+		for _, version := range versionList.Versions {
+			if objectLockEnabled {
+				if retainUntil := releaseObjectVersion(client, bucket, version, logger); retainUntil != nil {
+					retentionErr = laterRetention(retentionErr, &S3RetentionError{Bucket: *bucket, RetainUntil: *retainUntil})
+					continue
+				}
+			}
+			objects = append(objects, &s3.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
+		}
+		for _, marker := range versionList.DeleteMarkers {
+			objects = append(objects, &s3.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+		}
+
+		// versions and delete markers share the MaxKeys limit, but keep each call within the DeleteObjects limit regardless
+		for start := 0; start < len(objects); start += s3DeleteObjectsBatchSize {
+			end := start + s3DeleteObjectsBatchSize
+			if end > len(objects) {
+				end = len(objects)
+			}
+			output, err := client.DeleteObjects(&s3.DeleteObjectsInput{
+				Bucket: bucket,
+				Delete: &s3.Delete{Objects: objects[start:end], Quiet: aws.Bool(true)},
+				// only honoured for callers with s3:BypassGovernanceRetention, which the account admin role has
+				BypassGovernanceRetention: aws.Bool(objectLockEnabled),
+			})
+			if err != nil {
+				logger.Error(err, "Failed to delete object versions", "Bucket", *bucket)
+				errFlag = true
+				continue
+			}
+			for _, deleteError := range output.Errors {
+				logger.Info("Failed to delete object version", "Bucket", *bucket, "Key", aws.StringValue(deleteError.Key), "VersionID", aws.StringValue(deleteError.VersionId), "Error", aws.StringValue(deleteError.Message))
+				errFlag = true
+			}
+		}
+
+		if aws.BoolValue(versionList.IsTruncated) {
+			keyMarker = versionList.NextKeyMarker
+			versionIDMarker = versionList.NextVersionIdMarker
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveObjectVersionDeletion")
+	}
+	if retentionErr != nil {
+		return retentionErr
+	}
 	return nil
 }
 
 // CleanS3Instances cleans s3 buckets
-func CleanS3Instances(client clientpkg.Client, logger logr.Logger) error {
-	s3InstancesToBeDeleted := ListS3InstancesForDeletion(client, logger)
-	err := DeleteS3Buckets(client, s3InstancesToBeDeleted, logger)
+// ListBuckets returns the buckets of every region, so this runs once per account and deletes each bucket
+// with a client for the region it lives in, its metrics are all labelled with the region of the given client
+func CleanS3Instances(client clientpkg.Client, regionalClient RegionalClientFunc, logger logr.Logger) error {
+
+	errFlag := false
+	var retentionErr *S3RetentionError
+	s3InstancesToBeDeleted, err := ListS3InstancesForDeletion(client, logger)
 	if err != nil {
-		logger.Error(err, "Failed to delete s3 buckets")
 		return err
 	}
-	logger.Info("All S3 buckets have been deleted for this region")
+
+	bucketsByRegion, err := GroupS3BucketsByRegion(client, s3InstancesToBeDeleted, logger)
+	if err != nil {
+		errFlag = true
+	}
+
+	for region, buckets := range bucketsByRegion {
+		bucketClient, err := regionalClient(region)
+		if err != nil {
+			logger.Error(err, "Failed to initialize new AWS client", "BucketRegion", region)
+			errFlag = true
+			continue
+		}
+		err = deleteS3Buckets(bucketClient, buckets, client.GetRegion(), logger.WithValues("BucketRegion", region))
+		var regionRetentionErr *S3RetentionError
+		if errors.As(err, &regionRetentionErr) {
+			retentionErr = laterRetention(retentionErr, regionRetentionErr)
+		} else if err != nil {
+			logger.Error(err, "Failed to delete s3 buckets", "BucketRegion", region)
+			errFlag = true
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanS3Buckets")
+	}
+	// the account can not be reset while the buckets exist, but nothing more can be done before the date
+	if retentionErr != nil {
+		logger.Info("All S3 buckets but those under compliance retention have been deleted for this account", "UnshreddableUntil", retentionErr.RetainUntil)
+		return retentionErr
+	}
+	logger.Info("All S3 buckets have been deleted for this account")
 	return nil
 }
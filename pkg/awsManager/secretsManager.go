@@ -0,0 +1,84 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListSecretsForDeletion returns the ARNs of the Secrets Manager secrets in the region
+// secrets already scheduled for deletion are not returned by AWS
+func ListSecretsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var secretsToBeDeleted []*string
+	var token *string
+	for {
+		secretList, err := client.ListSecrets(&secretsmanager.ListSecretsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list Secrets Manager secrets")
+			return nil, err
+		}
+
+		for _, secret := range secretList.SecretList {
+			secretsToBeDeleted = append(secretsToBeDeleted, secret.ARN)
+		}
+
+		if secretList.NextToken != nil {
+			token = secretList.NextToken
+		} else {
+			break
+		}
+	}
+	return secretsToBeDeleted, nil
+}
+
+// DeleteSecrets deletes the given secrets
+// with a recoveryWindowDays of 0 the secrets are removed immediately and can not be restored
+func DeleteSecrets(client clientpkg.Client, secretsToBeDeleted []*string, recoveryWindowDays int64, logger logr.Logger) error {
+
+	if secretsToBeDeleted == nil {
+		return nil
+	}
+	var secretsNotDeleted []*string
+	for _, secretArn := range secretsToBeDeleted {
+		input := &secretsmanager.DeleteSecretInput{SecretId: secretArn}
+		if recoveryWindowDays == 0 {
+			input.ForceDeleteWithoutRecovery = aws.Bool(true)
+		} else {
+			input.RecoveryWindowInDays = aws.Int64(recoveryWindowDays)
+		}
+
+		_, err := client.DeleteSecret(input)
+		if err != nil {
+			logger.Error(err, "Failed to delete Secrets Manager secret", "ARN", *secretArn)
+			secretsNotDeleted = append(secretsNotDeleted, secretArn)
+			localMetrics.ResourceFail(localMetrics.Secret, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.Secret, client.GetRegion())
+	}
+
+	if secretsNotDeleted != nil {
+		return errors.New("FailedComprehensiveSecretDeletion")
+	}
+	return nil
+}
+
+// CleanSecrets lists and deletes Secrets Manager secrets
+func CleanSecrets(client clientpkg.Client, recoveryWindowDays int64, logger logr.Logger) error {
+	secretsToBeDeleted, err := ListSecretsForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+	err = DeleteSecrets(client, secretsToBeDeleted, recoveryWindowDays, logger)
+	if err != nil {
+		logger.Error(err, "Failed to delete Secrets Manager secrets")
+		return err
+	}
+	logger.Info("All Secrets Manager secrets have been deleted for this region")
+	return nil
+}
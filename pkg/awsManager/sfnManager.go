@@ -0,0 +1,74 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListStateMachinesForDeletion returns the ARNs of all Step Functions state machines in the region
+func ListStateMachinesForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var stateMachinesToBeDeleted []*string
+	var token *string
+	for {
+		stateMachineList, err := client.ListStateMachines(&sfn.ListStateMachinesInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list Step Functions state machines")
+			return nil, err
+		}
+
+		for _, stateMachine := range stateMachineList.StateMachines {
+			stateMachinesToBeDeleted = append(stateMachinesToBeDeleted, stateMachine.StateMachineArn)
+		}
+
+		if stateMachineList.NextToken != nil {
+			token = stateMachineList.NextToken
+		} else {
+			break
+		}
+	}
+	return stateMachinesToBeDeleted, nil
+}
+
+// DeleteStateMachines deletes the given state machines, running executions are stopped by AWS
+func DeleteStateMachines(client clientpkg.Client, stateMachinesToBeDeleted []*string, logger logr.Logger) error {
+
+	if stateMachinesToBeDeleted == nil {
+		return nil
+	}
+	var stateMachinesNotDeleted []*string
+	for _, stateMachineArn := range stateMachinesToBeDeleted {
+		_, err := client.DeleteStateMachine(&sfn.DeleteStateMachineInput{StateMachineArn: stateMachineArn})
+		if err != nil {
+			logger.Error(err, "Failed to delete state machine", "ARN", *stateMachineArn)
+			stateMachinesNotDeleted = append(stateMachinesNotDeleted, stateMachineArn)
+			localMetrics.ResourceFail(localMetrics.SfnStateMachine, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.SfnStateMachine, client.GetRegion())
+	}
+
+	if stateMachinesNotDeleted != nil {
+		return errors.New("FailedComprehensiveStateMachineDeletion")
+	}
+	return nil
+}
+
+// CleanStateMachines lists and deletes Step Functions state machines
+func CleanStateMachines(client clientpkg.Client, logger logr.Logger) error {
+	stateMachinesToBeDeleted, err := ListStateMachinesForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+	err = DeleteStateMachines(client, stateMachinesToBeDeleted, logger)
+	if err != nil {
+		logger.Error(err, "Failed to delete state machines")
+		return err
+	}
+	logger.Info("All Step Functions state machines have been deleted for this region")
+	return nil
+}
@@ -0,0 +1,140 @@
+package awsManager
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListSnsSubscriptionsForDeletion returns the ARNs of all confirmed subscriptions in the region
+// unconfirmed subscriptions report "PendingConfirmation" instead of an ARN and expire on their own
+func ListSnsSubscriptionsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var subscriptionsToBeDeleted []*string
+	var token *string
+	for {
+		subscriptionList, err := client.ListSubscriptions(&sns.ListSubscriptionsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list SNS subscriptions")
+			return nil, err
+		}
+
+		for _, subscription := range subscriptionList.Subscriptions {
+			if strings.HasPrefix(*subscription.SubscriptionArn, "arn:") {
+				subscriptionsToBeDeleted = append(subscriptionsToBeDeleted, subscription.SubscriptionArn)
+			}
+		}
+
+		if subscriptionList.NextToken != nil {
+			token = subscriptionList.NextToken
+		} else {
+			break
+		}
+	}
+	return subscriptionsToBeDeleted, nil
+}
+
+// DeleteSnsSubscriptions unsubscribes the given subscriptions
+func DeleteSnsSubscriptions(client clientpkg.Client, subscriptionsToBeDeleted []*string, logger logr.Logger) error {
+
+	if subscriptionsToBeDeleted == nil {
+		return nil
+	}
+	var subscriptionsNotDeleted []*string
+	for _, subscriptionArn := range subscriptionsToBeDeleted {
+		_, err := client.Unsubscribe(&sns.UnsubscribeInput{SubscriptionArn: subscriptionArn})
+		if err != nil {
+			logger.Error(err, "Failed to delete SNS subscription", "ARN", *subscriptionArn)
+			subscriptionsNotDeleted = append(subscriptionsNotDeleted, subscriptionArn)
+			localMetrics.ResourceFail(localMetrics.SnsSubscription, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.SnsSubscription, client.GetRegion())
+	}
+
+	if subscriptionsNotDeleted != nil {
+		return errors.New("FailedComprehensiveSnsSubscriptionDeletion")
+	}
+	return nil
+}
+
+// ListSnsTopicsForDeletion returns the ARNs of all SNS topics in the region
+func ListSnsTopicsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var topicsToBeDeleted []*string
+	var token *string
+	for {
+		topicList, err := client.ListTopics(&sns.ListTopicsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list SNS topics")
+			return nil, err
+		}
+
+		for _, topic := range topicList.Topics {
+			topicsToBeDeleted = append(topicsToBeDeleted, topic.TopicArn)
+		}
+
+		if topicList.NextToken != nil {
+			token = topicList.NextToken
+		} else {
+			break
+		}
+	}
+	return topicsToBeDeleted, nil
+}
+
+// DeleteSnsTopics deletes the given topics
+func DeleteSnsTopics(client clientpkg.Client, topicsToBeDeleted []*string, logger logr.Logger) error {
+
+	if topicsToBeDeleted == nil {
+		return nil
+	}
+	var topicsNotDeleted []*string
+	for _, topicArn := range topicsToBeDeleted {
+		_, err := client.DeleteTopic(&sns.DeleteTopicInput{TopicArn: topicArn})
+		if err != nil {
+			logger.Error(err, "Failed to delete SNS topic", "ARN", *topicArn)
+			topicsNotDeleted = append(topicsNotDeleted, topicArn)
+			localMetrics.ResourceFail(localMetrics.SnsTopic, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.SnsTopic, client.GetRegion())
+	}
+
+	if topicsNotDeleted != nil {
+		return errors.New("FailedComprehensiveSnsTopicDeletion")
+	}
+	return nil
+}
+
+// CleanSns removes SNS subscriptions, including those to topics owned by other accounts, then the topics
+func CleanSns(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+
+	subscriptionsToBeDeleted, err := ListSnsSubscriptionsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteSnsSubscriptions(client, subscriptionsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete SNS subscriptions")
+		errFlag = true
+	}
+
+	topicsToBeDeleted, err := ListSnsTopicsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteSnsTopics(client, topicsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete SNS topics")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanSns")
+	}
+	logger.Info("All SNS topics and subscriptions have been deleted for this region")
+	return nil
+}
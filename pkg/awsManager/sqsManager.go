@@ -0,0 +1,61 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListSqsQueuesForDeletion returns the URLs of the SQS queues in the region
+func ListSqsQueuesForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	// does not require pagination, at most 1000 queues are returned and the rest are picked up on the next pass
+	queueList, err := client.ListQueues(&sqs.ListQueuesInput{})
+	if err != nil {
+		logger.Error(err, "Failed to list SQS queues")
+		return nil, err
+	}
+	return queueList.QueueUrls, nil
+}
+
+// DeleteSqsQueues deletes the given queues, including any messages left in them
+func DeleteSqsQueues(client clientpkg.Client, queuesToBeDeleted []*string, logger logr.Logger) error {
+
+	if queuesToBeDeleted == nil {
+		return nil
+	}
+	var queuesNotDeleted []*string
+	for _, queueURL := range queuesToBeDeleted {
+		_, err := client.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: queueURL})
+		if err != nil {
+			logger.Error(err, "Failed to delete SQS queue", "URL", *queueURL)
+			queuesNotDeleted = append(queuesNotDeleted, queueURL)
+			localMetrics.ResourceFail(localMetrics.SqsQueue, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.SqsQueue, client.GetRegion())
+	}
+
+	if queuesNotDeleted != nil {
+		return errors.New("FailedComprehensiveSqsQueueDeletion")
+	}
+	return nil
+}
+
+// CleanSqsQueues lists and deletes SQS queues
+func CleanSqsQueues(client clientpkg.Client, logger logr.Logger) error {
+	queuesToBeDeleted, err := ListSqsQueuesForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+	err = DeleteSqsQueues(client, queuesToBeDeleted, logger)
+	if err != nil {
+		logger.Error(err, "Failed to delete SQS queues")
+		return err
+	}
+	logger.Info("All SQS queues have been deleted for this region")
+	return nil
+}
@@ -0,0 +1,215 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// maxSsmParameterBatchSize is the maximum number of parameters accepted by a single DeleteParameters call
+const maxSsmParameterBatchSize = 10
+
+// ListSsmParametersForDeletion returns the names of all SSM parameters in the region
+func ListSsmParametersForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var parametersToBeDeleted []*string
+	var token *string
+	for {
+		parameterList, err := client.DescribeParameters(&ssm.DescribeParametersInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list SSM parameters")
+			return nil, err
+		}
+
+		for _, parameter := range parameterList.Parameters {
+			parametersToBeDeleted = append(parametersToBeDeleted, parameter.Name)
+		}
+
+		if parameterList.NextToken != nil {
+			token = parameterList.NextToken
+		} else {
+			break
+		}
+	}
+	return parametersToBeDeleted, nil
+}
+
+// DeleteSsmParameters deletes the given parameters in batches
+func DeleteSsmParameters(client clientpkg.Client, parametersToBeDeleted []*string, logger logr.Logger) error {
+
+	if parametersToBeDeleted == nil {
+		return nil
+	}
+	errFlag := false
+	for start := 0; start < len(parametersToBeDeleted); start += maxSsmParameterBatchSize {
+		end := start + maxSsmParameterBatchSize
+		if end > len(parametersToBeDeleted) {
+			end = len(parametersToBeDeleted)
+		}
+		batch := parametersToBeDeleted[start:end]
+
+		output, err := client.DeleteParameters(&ssm.DeleteParametersInput{Names: batch})
+		if err != nil {
+			logger.Error(err, "Failed to delete SSM parameters", "Names", aws.StringValueSlice(batch))
+			errFlag = true
+			for range batch {
+				localMetrics.ResourceFail(localMetrics.SsmParameter, client.GetRegion())
+			}
+			continue
+		}
+
+		// parameters that no longer exist are reported as invalid, they are gone either way
+		for range output.DeletedParameters {
+			localMetrics.ResourceSuccess(localMetrics.SsmParameter, client.GetRegion())
+		}
+		for _, name := range output.InvalidParameters {
+			logger.Info("SSM parameter was not found", "Name", *name)
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveSsmParameterDeletion")
+	}
+	return nil
+}
+
+// ListSsmDocumentsForDeletion returns the names of the SSM documents owned by the account
+func ListSsmDocumentsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var documentsToBeDeleted []*string
+	var token *string
+	for {
+		documentList, err := client.ListDocuments(&ssm.ListDocumentsInput{
+			Filters:   []*ssm.DocumentKeyValuesFilter{{Key: aws.String(ssm.DocumentFilterKeyOwner), Values: []*string{aws.String("Self")}}},
+			NextToken: token,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to list SSM documents")
+			return nil, err
+		}
+
+		for _, document := range documentList.DocumentIdentifiers {
+			documentsToBeDeleted = append(documentsToBeDeleted, document.Name)
+		}
+
+		if documentList.NextToken != nil {
+			token = documentList.NextToken
+		} else {
+			break
+		}
+	}
+	return documentsToBeDeleted, nil
+}
+
+// DeleteSsmDocuments deletes the given documents along with all of their versions
+func DeleteSsmDocuments(client clientpkg.Client, documentsToBeDeleted []*string, logger logr.Logger) error {
+
+	if documentsToBeDeleted == nil {
+		return nil
+	}
+	var documentsNotDeleted []*string
+	for _, documentName := range documentsToBeDeleted {
+		_, err := client.DeleteDocument(&ssm.DeleteDocumentInput{Name: documentName})
+		if err != nil {
+			logger.Error(err, "Failed to delete SSM document", "Name", *documentName)
+			documentsNotDeleted = append(documentsNotDeleted, documentName)
+			localMetrics.ResourceFail(localMetrics.SsmDocument, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.SsmDocument, client.GetRegion())
+	}
+
+	if documentsNotDeleted != nil {
+		return errors.New("FailedComprehensiveSsmDocumentDeletion")
+	}
+	return nil
+}
+
+// ListSsmMaintenanceWindowsForDeletion returns the IDs of all SSM maintenance windows in the region
+func ListSsmMaintenanceWindowsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var windowsToBeDeleted []*string
+	var token *string
+	for {
+		windowList, err := client.DescribeMaintenanceWindows(&ssm.DescribeMaintenanceWindowsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list SSM maintenance windows")
+			return nil, err
+		}
+
+		for _, window := range windowList.WindowIdentities {
+			windowsToBeDeleted = append(windowsToBeDeleted, window.WindowId)
+		}
+
+		if windowList.NextToken != nil {
+			token = windowList.NextToken
+		} else {
+			break
+		}
+	}
+	return windowsToBeDeleted, nil
+}
+
+// DeleteSsmMaintenanceWindows deletes the given maintenance windows, their targets and tasks go with them
+func DeleteSsmMaintenanceWindows(client clientpkg.Client, windowsToBeDeleted []*string, logger logr.Logger) error {
+
+	if windowsToBeDeleted == nil {
+		return nil
+	}
+	var windowsNotDeleted []*string
+	for _, windowID := range windowsToBeDeleted {
+		_, err := client.DeleteMaintenanceWindow(&ssm.DeleteMaintenanceWindowInput{WindowId: windowID})
+		if err != nil {
+			logger.Error(err, "Failed to delete SSM maintenance window", "ID", *windowID)
+			windowsNotDeleted = append(windowsNotDeleted, windowID)
+			localMetrics.ResourceFail(localMetrics.SsmMaintWindow, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.SsmMaintWindow, client.GetRegion())
+	}
+
+	if windowsNotDeleted != nil {
+		return errors.New("FailedComprehensiveSsmMaintenanceWindowDeletion")
+	}
+	return nil
+}
+
+// CleanSsm deletes SSM maintenance windows, documents owned by the account and parameters
+func CleanSsm(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+
+	windowsToBeDeleted, err := ListSsmMaintenanceWindowsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteSsmMaintenanceWindows(client, windowsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete SSM maintenance windows")
+		errFlag = true
+	}
+
+	documentsToBeDeleted, err := ListSsmDocumentsForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteSsmDocuments(client, documentsToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete SSM documents")
+		errFlag = true
+	}
+
+	parametersToBeDeleted, err := ListSsmParametersForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteSsmParameters(client, parametersToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete SSM parameters")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanSsm")
+	}
+	logger.Info("All SSM parameters, documents and maintenance windows have been deleted for this region")
+	return nil
+}
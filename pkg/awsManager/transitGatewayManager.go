@@ -0,0 +1,184 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// DeleteTransitGatewayAttachments deletes the VPC and peering attachments of the transit gateways in the region
+// VPN attachments go away with their VPN connection, Direct Connect attachments can only be removed from the Direct Connect gateway
+func DeleteTransitGatewayAttachments(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		attachmentList, err := client.DescribeTransitGatewayAttachments(&ec2.DescribeTransitGatewayAttachmentsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list Transit Gateway attachments")
+			return err
+		}
+
+		for _, attachment := range attachmentList.TransitGatewayAttachments {
+			state := aws.StringValue(attachment.State)
+			if state == ec2.TransitGatewayAttachmentStateDeleting || state == ec2.TransitGatewayAttachmentStateDeleted {
+				continue
+			}
+
+			switch aws.StringValue(attachment.ResourceType) {
+			case ec2.TransitGatewayAttachmentResourceTypeVpc:
+				_, err = client.DeleteTransitGatewayVpcAttachment(&ec2.DeleteTransitGatewayVpcAttachmentInput{TransitGatewayAttachmentId: attachment.TransitGatewayAttachmentId})
+			case ec2.TransitGatewayAttachmentResourceTypeTgwPeering:
+				_, err = client.DeleteTransitGatewayPeeringAttachment(&ec2.DeleteTransitGatewayPeeringAttachmentInput{TransitGatewayAttachmentId: attachment.TransitGatewayAttachmentId})
+			default:
+				continue
+			}
+			if err != nil {
+				logger.Error(err, "Failed to delete Transit Gateway attachment", "ID", *attachment.TransitGatewayAttachmentId)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.TgwAttachment, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.TgwAttachment, client.GetRegion())
+		}
+
+		if attachmentList.NextToken != nil {
+			token = attachmentList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveTransitGatewayAttachmentDeletion")
+	}
+	return nil
+}
+
+// DeleteTransitGatewayRouteTables deletes the non-default route tables of the transit gateways in the region
+// the default association route table is removed along with its transit gateway
+func DeleteTransitGatewayRouteTables(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+	var token *string
+	for {
+		routeTableList, err := client.DescribeTransitGatewayRouteTables(&ec2.DescribeTransitGatewayRouteTablesInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list Transit Gateway route tables")
+			return err
+		}
+
+		for _, routeTable := range routeTableList.TransitGatewayRouteTables {
+			state := aws.StringValue(routeTable.State)
+			if aws.BoolValue(routeTable.DefaultAssociationRouteTable) || state == ec2.TransitGatewayRouteTableStateDeleting || state == ec2.TransitGatewayRouteTableStateDeleted {
+				continue
+			}
+
+			_, err = client.DeleteTransitGatewayRouteTable(&ec2.DeleteTransitGatewayRouteTableInput{TransitGatewayRouteTableId: routeTable.TransitGatewayRouteTableId})
+			if err != nil {
+				logger.Error(err, "Failed to delete Transit Gateway route table", "ID", *routeTable.TransitGatewayRouteTableId)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.TgwRouteTable, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.TgwRouteTable, client.GetRegion())
+		}
+
+		if routeTableList.NextToken != nil {
+			token = routeTableList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveTransitGatewayRouteTableDeletion")
+	}
+	return nil
+}
+
+// ListTransitGatewaysForDeletion returns the IDs of the transit gateways in the region that are not already being deleted
+func ListTransitGatewaysForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var gatewaysToBeDeleted []*string
+	var token *string
+	for {
+		gatewayList, err := client.DescribeTransitGateways(&ec2.DescribeTransitGatewaysInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list Transit Gateways")
+			return nil, err
+		}
+
+		for _, gateway := range gatewayList.TransitGateways {
+			state := aws.StringValue(gateway.State)
+			if state != ec2.TransitGatewayStateDeleting && state != ec2.TransitGatewayStateDeleted {
+				gatewaysToBeDeleted = append(gatewaysToBeDeleted, gateway.TransitGatewayId)
+			}
+		}
+
+		if gatewayList.NextToken != nil {
+			token = gatewayList.NextToken
+		} else {
+			break
+		}
+	}
+	return gatewaysToBeDeleted, nil
+}
+
+// DeleteTransitGateways deletes the given transit gateways
+// a gateway with attachments still being deleted fails, and is picked up again on the next pass
+func DeleteTransitGateways(client clientpkg.Client, gatewaysToBeDeleted []*string, logger logr.Logger) error {
+
+	if gatewaysToBeDeleted == nil {
+		return nil
+	}
+	var gatewaysNotDeleted []*string
+	for _, gatewayID := range gatewaysToBeDeleted {
+		_, err := client.DeleteTransitGateway(&ec2.DeleteTransitGatewayInput{TransitGatewayId: gatewayID})
+		if err != nil {
+			logger.Error(err, "Failed to delete Transit Gateway", "ID", *gatewayID)
+			gatewaysNotDeleted = append(gatewaysNotDeleted, gatewayID)
+			localMetrics.ResourceFail(localMetrics.TransitGateway, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.TransitGateway, client.GetRegion())
+	}
+
+	if gatewaysNotDeleted != nil {
+		return errors.New("FailedComprehensiveTransitGatewayDeletion")
+	}
+	return nil
+}
+
+// CleanTransitGateways deletes transit gateway attachments, route tables and finally the transit gateways themselves
+// VPC attachments keep ENIs in the attached subnets, so this has to run before CleanVpcInstances
+func CleanTransitGateways(client clientpkg.Client, logger logr.Logger) error {
+
+	errFlag := false
+
+	if err := DeleteTransitGatewayAttachments(client, logger); err != nil {
+		errFlag = true
+	}
+
+	if err := DeleteTransitGatewayRouteTables(client, logger); err != nil {
+		errFlag = true
+	}
+
+	gatewaysToBeDeleted, err := ListTransitGatewaysForDeletion(client, logger)
+	if err != nil {
+		errFlag = true
+	} else if err = DeleteTransitGateways(client, gatewaysToBeDeleted, logger); err != nil {
+		logger.Error(err, "Failed to delete Transit Gateways")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanTransitGateways")
+	}
+	logger.Info("All Transit Gateways have been deleted for this region")
+	return nil
+}
@@ -0,0 +1,135 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListVpcEndpointServicesForDeletion returns the IDs of the endpoint services (PrivateLink) offered by the account in the region
+func ListVpcEndpointServicesForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var servicesToBeDeleted []*string
+	var token *string
+	for {
+		serviceList, err := client.DescribeVpcEndpointServiceConfigurations(&ec2.DescribeVpcEndpointServiceConfigurationsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list VPC endpoint service configurations")
+			return nil, err
+		}
+
+		for _, service := range serviceList.ServiceConfigurations {
+			state := aws.StringValue(service.ServiceState)
+			if state != ec2.ServiceStateDeleting && state != ec2.ServiceStateDeleted {
+				servicesToBeDeleted = append(servicesToBeDeleted, service.ServiceId)
+			}
+		}
+
+		if serviceList.NextToken != nil {
+			token = serviceList.NextToken
+		} else {
+			break
+		}
+	}
+	return servicesToBeDeleted, nil
+}
+
+// RejectVpcEndpointConnections rejects the endpoints, possibly owned by other accounts, connected to the given service
+// a service configuration can not be deleted while endpoints are still connected to it
+func RejectVpcEndpointConnections(client clientpkg.Client, serviceID *string, logger logr.Logger) error {
+
+	var endpointsToBeRejected []*string
+	var token *string
+	for {
+		connectionList, err := client.DescribeVpcEndpointConnections(&ec2.DescribeVpcEndpointConnectionsInput{
+			Filters:   []*ec2.Filter{{Name: aws.String("service-id"), Values: []*string{serviceID}}},
+			NextToken: token,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to list VPC endpoint connections", "ServiceID", *serviceID)
+			return err
+		}
+
+		for _, connection := range connectionList.VpcEndpointConnections {
+			state := aws.StringValue(connection.VpcEndpointState)
+			if state == ec2.StateAvailable || state == ec2.StatePendingAcceptance || state == ec2.StatePending {
+				endpointsToBeRejected = append(endpointsToBeRejected, connection.VpcEndpointId)
+			}
+		}
+
+		if connectionList.NextToken != nil {
+			token = connectionList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if endpointsToBeRejected == nil {
+		return nil
+	}
+
+	_, err := client.RejectVpcEndpointConnections(&ec2.RejectVpcEndpointConnectionsInput{ServiceId: serviceID, VpcEndpointIds: endpointsToBeRejected})
+	if err != nil {
+		logger.Error(err, "Failed to reject VPC endpoint connections", "ServiceID", *serviceID)
+		return err
+	}
+	return nil
+}
+
+// DeleteVpcEndpointServices rejects the connections of the given endpoint services and deletes their configurations
+func DeleteVpcEndpointServices(client clientpkg.Client, servicesToBeDeleted []*string, logger logr.Logger) error {
+
+	if servicesToBeDeleted == nil {
+		return nil
+	}
+	errFlag := false
+	for _, serviceID := range servicesToBeDeleted {
+		// the deletion is still attempted, the connections may be gone already
+		if err := RejectVpcEndpointConnections(client, serviceID, logger); err != nil {
+			logger.Error(err, "Failed to reject the connections of VPC endpoint service", "ServiceID", *serviceID)
+			errFlag = true
+		}
+	}
+
+	output, err := client.DeleteVpcEndpointServiceConfigurations(&ec2.DeleteVpcEndpointServiceConfigurationsInput{ServiceIds: servicesToBeDeleted})
+	if err != nil {
+		logger.Error(err, "Failed to delete VPC endpoint service configurations")
+		for range servicesToBeDeleted {
+			localMetrics.ResourceFail(localMetrics.EndpointService, client.GetRegion())
+		}
+		return err
+	}
+
+	for _, item := range output.Unsuccessful {
+		logger.Info("Failed to delete VPC endpoint service configuration", "ID", aws.StringValue(item.ResourceId), "Error", item.Error.String())
+		localMetrics.ResourceFail(localMetrics.EndpointService, client.GetRegion())
+	}
+	for i := len(output.Unsuccessful); i < len(servicesToBeDeleted); i++ {
+		localMetrics.ResourceSuccess(localMetrics.EndpointService, client.GetRegion())
+	}
+
+	if errFlag || len(output.Unsuccessful) > 0 {
+		return errors.New("FailedComprehensiveVpcEndpointServiceDeletion")
+	}
+	return nil
+}
+
+// CleanVpcEndpointServices lists and deletes VPC endpoint service configurations
+// the network and gateway load balancers behind a service can not be deleted before it, so this runs ahead of CleanElbv2
+func CleanVpcEndpointServices(client clientpkg.Client, logger logr.Logger) error {
+	servicesToBeDeleted, err := ListVpcEndpointServicesForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+	err = DeleteVpcEndpointServices(client, servicesToBeDeleted, logger)
+	if err != nil {
+		logger.Error(err, "Failed to delete VPC endpoint services")
+		return err
+	}
+	logger.Info("All VPC endpoint services have been deleted for this region")
+	return nil
+}
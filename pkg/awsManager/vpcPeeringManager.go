@@ -0,0 +1,82 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// ListVpcPeeringConnectionsForDeletion returns the IDs of the peering connections in the region that are still active or being set up
+// rejected, failed, expired and deleted connections are cleared by AWS on their own
+func ListVpcPeeringConnectionsForDeletion(client clientpkg.Client, logger logr.Logger) ([]*string, error) {
+
+	var peeringConnectionsToBeDeleted []*string
+	var token *string
+	for {
+		peeringConnectionList, err := client.DescribeVpcPeeringConnections(&ec2.DescribeVpcPeeringConnectionsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list VPC peering connections")
+			return nil, err
+		}
+
+		for _, peeringConnection := range peeringConnectionList.VpcPeeringConnections {
+			if peeringConnection.Status == nil {
+				continue
+			}
+			switch aws.StringValue(peeringConnection.Status.Code) {
+			case ec2.VpcPeeringConnectionStateReasonCodeActive, ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, ec2.VpcPeeringConnectionStateReasonCodeProvisioning:
+				peeringConnectionsToBeDeleted = append(peeringConnectionsToBeDeleted, peeringConnection.VpcPeeringConnectionId)
+			}
+		}
+
+		if peeringConnectionList.NextToken != nil {
+			token = peeringConnectionList.NextToken
+		} else {
+			break
+		}
+	}
+	return peeringConnectionsToBeDeleted, nil
+}
+
+// DeleteVpcPeeringConnections deletes the given peering connections
+func DeleteVpcPeeringConnections(client clientpkg.Client, peeringConnectionsToBeDeleted []*string, logger logr.Logger) error {
+
+	if peeringConnectionsToBeDeleted == nil {
+		return nil
+	}
+	var peeringConnectionsNotDeleted []*string
+	for _, peeringConnectionID := range peeringConnectionsToBeDeleted {
+		_, err := client.DeleteVpcPeeringConnection(&ec2.DeleteVpcPeeringConnectionInput{VpcPeeringConnectionId: peeringConnectionID})
+		if err != nil {
+			logger.Error(err, "Failed to delete VPC peering connection", "ID", *peeringConnectionID)
+			peeringConnectionsNotDeleted = append(peeringConnectionsNotDeleted, peeringConnectionID)
+			localMetrics.ResourceFail(localMetrics.VpcPeering, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.VpcPeering, client.GetRegion())
+	}
+
+	if peeringConnectionsNotDeleted != nil {
+		return errors.New("FailedComprehensiveVpcPeeringConnectionDeletion")
+	}
+	return nil
+}
+
+// CleanVpcPeeringConnections lists and deletes VPC peering connections, it has to run before CleanVpcInstances
+func CleanVpcPeeringConnections(client clientpkg.Client, logger logr.Logger) error {
+	peeringConnectionsToBeDeleted, err := ListVpcPeeringConnectionsForDeletion(client, logger)
+	if err != nil {
+		return err
+	}
+	err = DeleteVpcPeeringConnections(client, peeringConnectionsToBeDeleted, logger)
+	if err != nil {
+		logger.Error(err, "Failed to delete VPC peering connections")
+		return err
+	}
+	logger.Info("All VPC peering connections have been deleted for this region")
+	return nil
+}
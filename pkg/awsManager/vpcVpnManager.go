@@ -3,10 +3,14 @@ package awsManager
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/go-logr/logr"
 	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
 	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
@@ -41,56 +45,88 @@ func ListVPCforDeletion(client clientpkg.Client) ([]*string, error) {
 	return vpcToBeDeleted, nil
 }
 
-// CleanVpcInstances lists and removes listed vcp instances
-func CleanVpcInstances(client clientpkg.Client, logger logr.Logger) error {
+// CleanVpcInstances lists and removes listed vcp instances, then deletes the VPN connections, VPN gateways, customer gateways and prefix lists of the region
+// the steps do not depend on each other succeeding, so each runs even if the previous ones failed
+// waitTimeout bounds how long the VPCs of the region wait, all together, for their instances, NAT gateways and load balancers to be deleted, 0 disables waiting
+func CleanVpcInstances(client clientpkg.Client, waitTimeout time.Duration, logger logr.Logger) error {
+
+	errFlag := false
+
 	vpcToBeDeleted, err := ListVPCforDeletion(client)
 	if err != nil {
 		logger.Error(err, "Failed to list VPCs")
-		return err
-	}
-
-	err = DeleteVpcInstances(client, vpcToBeDeleted, logger)
-	if err != nil {
+		errFlag = true
+	} else if err = DeleteVpcInstances(client, vpcToBeDeleted, waitTimeout, logger); err != nil {
 		logger.Error(err, "Failed to delete VPCs")
-		return err
+		errFlag = true
 	}
 
 	// need to clear VPN connection now , VPC gateway has been detached already by now (function call in -> deleteVpcInstances()) , if not this will throw an error .
-	err = DeleteVpnConnections(client, logger)
-	if err != nil {
+	if err = DeleteVpnConnections(client, logger); err != nil {
 		logger.Error(err, "Failed to delete VPN connections")
-		return err
+		errFlag = true
+	}
+
+	// VPN gateways and customer gateways are left behind once their VPN connections are gone
+	if err = DeleteVpnGateways(client, logger); err != nil {
+		logger.Error(err, "Failed to delete VPN gateways")
+		errFlag = true
 	}
 
+	if err = DeleteCustomerGateways(client, logger); err != nil {
+		logger.Error(err, "Failed to delete Customer gateways")
+		errFlag = true
+	}
+
+	// prefix lists are regional, they can only go once the routes and security groups of the VPCs referencing them are deleted
+	if err = DeleteManagedPrefixLists(client, logger); err != nil {
+		logger.Error(err, "Failed to delete managed prefix lists")
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanVpcInstances")
+	}
 	logger.Info("VPCs deleted successfully")
 	return nil
 }
 
 // DeleteVpcInstances deletes all VPCs given
-func DeleteVpcInstances(client clientpkg.Client, vpcToBeDeleted []*string, logger logr.Logger) error {
+// waitTimeout bounds the waits of all the VPCs together, so a region with many VPCs does not block for as many timeouts
+func DeleteVpcInstances(client clientpkg.Client, vpcToBeDeleted []*string, waitTimeout time.Duration, logger logr.Logger) error {
 
 	errFlag := false
+	waitUntilDeadline := waitDeadline(waitTimeout)
 	for _, vpcID := range vpcToBeDeleted {
 
 		//need to clean out the dependencies
 		// EC2 + S3 are already cleaned out by now
+		// delete flow logs publishing this vpc's traffic
+		err := DeleteVpcFlowLogs(client, vpcID, logger)
+		if err != nil {
+			logger.Error(err, "Failed to delete VPC flow logs")
+			errFlag = true
+		}
 		// delete vpc endpoints
-		err := DeleteVpcEndpoint(client, vpcID, logger)
+		err = DeleteVpcEndpoint(client, vpcID, logger)
 		if err != nil {
 			logger.Error(err, "Failed to delete VPC endpoints")
 			errFlag = true
 		}
 		// clear out all ELB
+		loadBalancersDeleted := true
 		err = DeleteELB(client, vpcID, logger)
 		if err != nil {
 			logger.Error(err, "Failed to delete ELBs")
 			errFlag = true
+			loadBalancersDeleted = false
 		}
 		// clear out all network load balancer
 		err = DeleteNetworkLoadBalancer(client, vpcID, logger)
 		if err != nil {
 			logger.Error(err, "Failed to delete Network Load Balancers")
 			errFlag = true
+			loadBalancersDeleted = false
 		}
 		// delete NAT gateway
 		err = DeleteNatgateway(client, vpcID, logger)
@@ -98,6 +134,11 @@ func DeleteVpcInstances(client clientpkg.Client, vpcToBeDeleted []*string, logge
 			logger.Error(err, "Failed to delete Nat Gateway")
 			errFlag = true
 		}
+		// terminated instances, NAT gateways and load balancers release their network interfaces asynchronously
+		// the deletions that follow report whatever is still in the way, so a failed wait does not fail the pass on its own
+		if err := WaitForVpcDependencies(client, vpcID, waitUntilDeadline, loadBalancersDeleted, logger); err != nil {
+			logger.Info("Carrying on without waiting for the remaining VPC dependencies", "VpcID", *vpcID, "Reason", err.Error())
+		}
 		// detach and delete network interface
 		err = DetachAndDeleteNetworkInterface(client, vpcID, logger)
 		if err != nil {
@@ -110,6 +151,18 @@ func DeleteVpcInstances(client clientpkg.Client, vpcToBeDeleted []*string, logge
 			logger.Error(err, "Failed to delete Gateway")
 			errFlag = true
 		}
+		// delete egress-only internet gateway
+		err = DeleteEgressOnlyInternetGateways(client, vpcID, logger)
+		if err != nil {
+			logger.Error(err, "Failed to delete Egress-only Internet Gateway")
+			errFlag = true
+		}
+		// delete carrier gateway
+		err = DeleteCarrierGateways(client, vpcID, logger)
+		if err != nil {
+			logger.Error(err, "Failed to delete Carrier Gateway")
+			errFlag = true
+		}
 		//detach VPN gateway
 		err = DetachVpnGateway(client, vpcID, logger)
 		if err != nil {
@@ -134,6 +187,12 @@ func DeleteVpcInstances(client clientpkg.Client, vpcToBeDeleted []*string, logge
 			logger.Error(err, "Failed to delete Subnet for VPCs")
 			errFlag = true
 		}
+		// with the subnets gone, secondary cidr blocks can be released
+		err = DisassociateSecondaryVpcCidrBlocks(client, vpcID, logger)
+		if err != nil {
+			logger.Error(err, "Failed to disassociate secondary CIDR blocks")
+			errFlag = true
+		}
 		//now cleaning security groups
 		err = DeleteSecurityGroups(client, vpcID, logger)
 		if err != nil {
@@ -227,13 +286,10 @@ func DeleteNetworkLoadBalancer(client clientpkg.Client, vpcID *string, logger lo
 
 		for _, networkLoadBalancer := range networkLoadBalancerList.LoadBalancers {
 			if *networkLoadBalancer.VpcId == *vpcID {
-				_, err := client.DeleteLoadBalancer2(&elbv2.DeleteLoadBalancerInput{LoadBalancerArn: networkLoadBalancer.LoadBalancerArn})
+				err := deleteLoadBalancerV2(client, networkLoadBalancer.LoadBalancerArn, logger)
 				if err != nil {
 					logger.Error(err, "Failed to delete Network Load Balancer", "Name", *networkLoadBalancer.LoadBalancerName)
-					localMetrics.ResourceFail(localMetrics.NetworkLoadBalancer, client.GetRegion())
-					continue
 				}
-				localMetrics.ResourceSuccess(localMetrics.NetworkLoadBalancer, client.GetRegion())
 			}
 		}
 		if networkLoadBalancerList.NextMarker != nil {
@@ -365,7 +421,8 @@ func DeleteRouteTables(client clientpkg.Client, vpcId *string, logger logr.Logge
 
 		for _, routeTable := range routeTableList.RouteTables {
 			for _, association := range routeTable.Associations {
-				if *routeTable.VpcId == *vpcId && association.RouteTableAssociationId != nil {
+				// the main association can only be replaced, never removed
+				if *routeTable.VpcId == *vpcId && association.RouteTableAssociationId != nil && !aws.BoolValue(association.Main) {
 					//disassociate route table
 					_, err = client.DisassociateRouteTable(&ec2.DisassociateRouteTableInput{AssociationId: association.RouteTableAssociationId})
 					if err != nil {
@@ -377,6 +434,13 @@ func DeleteRouteTables(client clientpkg.Client, vpcId *string, logger logr.Logge
 
 		for _, routeTable := range routeTableList.RouteTables {
 			if *routeTable.VpcId == *vpcId {
+				// the main route table goes away with the VPC, only its custom routes are removed
+				if isMainRouteTable(routeTable) {
+					if err = ScrubMainRouteTable(client, routeTable, logger); err != nil {
+						logger.Error(err, "Failed to scrub main route-table", "ID", *routeTable.RouteTableId)
+					}
+					continue
+				}
 
 				_, err = client.DeleteRouteTable(&ec2.DeleteRouteTableInput{RouteTableId: routeTable.RouteTableId})
 				if err != nil {
@@ -398,8 +462,57 @@ func DeleteRouteTables(client clientpkg.Client, vpcId *string, logger logr.Logge
 	return nil
 }
 
+// isMainRouteTable reports whether the route table is the main route table of its VPC
+func isMainRouteTable(routeTable *ec2.RouteTable) bool {
+	for _, association := range routeTable.Associations {
+		if aws.BoolValue(association.Main) {
+			return true
+		}
+	}
+	return false
+}
+
+// ScrubMainRouteTable deletes every route of a main route table but the local ones
+func ScrubMainRouteTable(client clientpkg.Client, routeTable *ec2.RouteTable, logger logr.Logger) error {
+	return deleteCustomRoutes(client, routeTable, func(*ec2.Route) bool { return false }, logger)
+}
+
+// isGatewayEndpointRoute reports whether the route was added by a gateway endpoint for the prefix list of its service
+func isGatewayEndpointRoute(route *ec2.Route) bool {
+	return route.DestinationPrefixListId != nil && strings.HasPrefix(aws.StringValue(route.GatewayId), "vpce-")
+}
+
+// deleteCustomRoutes deletes the routes of the route table that were added to it, except those matched by keepRoute
+// local routes come with the table and propagated routes are withdrawn by their virtual private gateway, neither can be deleted
+// prefix list routes through a gateway endpoint belong to that endpoint, which takes them along when deleted
+func deleteCustomRoutes(client clientpkg.Client, routeTable *ec2.RouteTable, keepRoute func(*ec2.Route) bool, logger logr.Logger) error {
+
+	errFlag := false
+	for _, route := range routeTable.Routes {
+		if aws.StringValue(route.Origin) != ec2.RouteOriginCreateRoute || aws.StringValue(route.GatewayId) == "local" || isGatewayEndpointRoute(route) || keepRoute(route) {
+			continue
+		}
+		_, err := client.DeleteRoute(&ec2.DeleteRouteInput{
+			RouteTableId:             routeTable.RouteTableId,
+			DestinationCidrBlock:     route.DestinationCidrBlock,
+			DestinationIpv6CidrBlock: route.DestinationIpv6CidrBlock,
+			DestinationPrefixListId:  route.DestinationPrefixListId,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to delete route", "RouteTableID", *routeTable.RouteTableId)
+			errFlag = true
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveRouteDeletion")
+	}
+	return nil
+}
+
 func DeleteNetworkAcl(client clientpkg.Client, vpcId *string, logger logr.Logger) error {
 
+	errFlag := false
 	var token *string
 
 	for {
@@ -411,6 +524,15 @@ func DeleteNetworkAcl(client clientpkg.Client, vpcId *string, logger logr.Logger
 
 		for _, acl := range aclList.NetworkAcls {
 			if *acl.VpcId == *vpcId {
+				// the default ACL goes away with the VPC and can not be deleted on its own
+				if aws.BoolValue(acl.IsDefault) {
+					if err = ResetDefaultNetworkAcl(client, acl, logger); err != nil {
+						logger.Error(err, "Failed to reset default ACL", "ID", *acl.NetworkAclId)
+						localMetrics.ResourceFail(localMetrics.NetworkACL, client.GetRegion())
+						errFlag = true
+					}
+					continue
+				}
 				_, err := client.DeleteNetworkAcl(&ec2.DeleteNetworkAclInput{NetworkAclId: acl.NetworkAclId})
 				if err != nil {
 					logger.Error(err, "Failed to delete ACL", "ID", *acl.NetworkAclId)
@@ -428,11 +550,15 @@ func DeleteNetworkAcl(client clientpkg.Client, vpcId *string, logger logr.Logger
 		}
 	}
 
+	if errFlag {
+		return errors.New("FailedToResetDefaultNetworkAcl")
+	}
 	return nil
 }
 
 func DeleteSecurityGroups(client clientpkg.Client, vpcId *string, logger logr.Logger) error {
 
+	errFlag := false
 	var token *string
 
 	for {
@@ -444,6 +570,15 @@ func DeleteSecurityGroups(client clientpkg.Client, vpcId *string, logger logr.Lo
 
 		for _, securityGroup := range securityGroupList.SecurityGroups {
 			if *securityGroup.VpcId == *vpcId {
+				// the default group goes away with the VPC, only the rules that may reference other groups are removed
+				if isDefaultSecurityGroup(securityGroup) {
+					if err = ScrubDefaultSecurityGroup(client, securityGroup, logger); err != nil {
+						logger.Error(err, "Failed to scrub default Security Group", "ID", *securityGroup.GroupId)
+						localMetrics.ResourceFail(localMetrics.SecurityGroup, client.GetRegion())
+						errFlag = true
+					}
+					continue
+				}
 				_, err = client.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{IpPermissions: securityGroup.IpPermissions, GroupId: securityGroup.GroupId})
 				if err != nil {
 					logger.Error(err, "Failed to delete all permissions")
@@ -453,7 +588,7 @@ func DeleteSecurityGroups(client clientpkg.Client, vpcId *string, logger logr.Lo
 		}
 
 		for _, securityGroup := range securityGroupList.SecurityGroups {
-			if *securityGroup.VpcId == *vpcId {
+			if *securityGroup.VpcId == *vpcId && !isDefaultSecurityGroup(securityGroup) {
 				_, err = client.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{GroupId: securityGroup.GroupId})
 				if err != nil {
 					logger.Error(err, "Failed to delete Security Group", "ID", *securityGroup.GroupId)
@@ -470,6 +605,203 @@ func DeleteSecurityGroups(client clientpkg.Client, vpcId *string, logger logr.Lo
 		}
 	}
 
+	if errFlag {
+		return errors.New("FailedToScrubDefaultSecurityGroup")
+	}
+	return nil
+}
+
+// isDefaultSecurityGroup reports whether the security group is the one AWS creates with the VPC
+func isDefaultSecurityGroup(securityGroup *ec2.SecurityGroup) bool {
+	return aws.StringValue(securityGroup.GroupName) == defaultSecurityGroupName
+}
+
+// splitIpPermission returns one permission per source of the given permission, so single sources can be kept or revoked
+func splitIpPermission(permission *ec2.IpPermission) []*ec2.IpPermission {
+
+	newPermission := func() *ec2.IpPermission {
+		return &ec2.IpPermission{IpProtocol: permission.IpProtocol, FromPort: permission.FromPort, ToPort: permission.ToPort}
+	}
+
+	var permissions []*ec2.IpPermission
+	for _, ipRange := range permission.IpRanges {
+		p := newPermission()
+		p.IpRanges = []*ec2.IpRange{ipRange}
+		permissions = append(permissions, p)
+	}
+	for _, ipv6Range := range permission.Ipv6Ranges {
+		p := newPermission()
+		p.Ipv6Ranges = []*ec2.Ipv6Range{ipv6Range}
+		permissions = append(permissions, p)
+	}
+	for _, prefixListID := range permission.PrefixListIds {
+		p := newPermission()
+		p.PrefixListIds = []*ec2.PrefixListId{prefixListID}
+		permissions = append(permissions, p)
+	}
+	for _, groupPair := range permission.UserIdGroupPairs {
+		p := newPermission()
+		p.UserIdGroupPairs = []*ec2.UserIdGroupPair{groupPair}
+		permissions = append(permissions, p)
+	}
+	return permissions
+}
+
+// customSecurityGroupRules returns the rules of the default security group that AWS did not create with it
+// AWS allows all inbound traffic from the group itself and all outbound traffic to 0.0.0.0/0 and ::/0
+func customSecurityGroupRules(securityGroup *ec2.SecurityGroup) (ingress []*ec2.IpPermission, egress []*ec2.IpPermission) {
+
+	for _, permission := range securityGroup.IpPermissions {
+		for _, p := range splitIpPermission(permission) {
+			if aws.StringValue(p.IpProtocol) == "-1" && len(p.UserIdGroupPairs) == 1 && aws.StringValue(p.UserIdGroupPairs[0].GroupId) == aws.StringValue(securityGroup.GroupId) {
+				continue
+			}
+			ingress = append(ingress, p)
+		}
+	}
+
+	for _, permission := range securityGroup.IpPermissionsEgress {
+		for _, p := range splitIpPermission(permission) {
+			if aws.StringValue(p.IpProtocol) == "-1" && len(p.IpRanges) == 1 && aws.StringValue(p.IpRanges[0].CidrIp) == "0.0.0.0/0" {
+				continue
+			}
+			if aws.StringValue(p.IpProtocol) == "-1" && len(p.Ipv6Ranges) == 1 && aws.StringValue(p.Ipv6Ranges[0].CidrIpv6) == "::/0" {
+				continue
+			}
+			egress = append(egress, p)
+		}
+	}
+	return ingress, egress
+}
+
+// ScrubDefaultSecurityGroup revokes the custom ingress and egress rules of a default security group, leaving the rules AWS created
+func ScrubDefaultSecurityGroup(client clientpkg.Client, securityGroup *ec2.SecurityGroup, logger logr.Logger) error {
+
+	errFlag := false
+	ingress, egress := customSecurityGroupRules(securityGroup)
+
+	if ingress != nil {
+		_, err := client.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{IpPermissions: ingress, GroupId: securityGroup.GroupId})
+		if err != nil {
+			logger.Error(err, "Failed to revoke custom ingress rules of the default Security Group", "ID", *securityGroup.GroupId)
+			errFlag = true
+		}
+	}
+	if egress != nil {
+		_, err := client.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{IpPermissions: egress, GroupId: securityGroup.GroupId})
+		if err != nil {
+			logger.Error(err, "Failed to revoke custom egress rules of the default Security Group", "ID", *securityGroup.GroupId)
+			errFlag = true
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedToScrubDefaultSecurityGroup")
+	}
+	return nil
+}
+
+// defaultNetworkAclRuleNumber is the rule number of the IPv4 allow all entries AWS creates in a default network ACL
+// the IPv6 allow all entries of a VPC with an IPv6 CIDR block come right after
+const defaultNetworkAclRuleNumber = 100
+
+// isAwsNetworkAclEntry reports whether the entry is one AWS creates in a default network ACL
+// the catch-all deny entries (32767 and above) can not be removed at all
+func isAwsNetworkAclEntry(entry *ec2.NetworkAclEntry) bool {
+
+	ruleNumber := aws.Int64Value(entry.RuleNumber)
+	if ruleNumber >= 32767 {
+		return true
+	}
+	if aws.StringValue(entry.Protocol) != "-1" || aws.StringValue(entry.RuleAction) != ec2.RuleActionAllow {
+		return false
+	}
+	return (ruleNumber == defaultNetworkAclRuleNumber && aws.StringValue(entry.CidrBlock) == "0.0.0.0/0") ||
+		(ruleNumber == defaultNetworkAclRuleNumber+1 && aws.StringValue(entry.Ipv6CidrBlock) == "::/0")
+}
+
+// vpcHasIpv6CidrBlock reports whether an IPv6 CIDR block is associated with the VPC
+func vpcHasIpv6CidrBlock(client clientpkg.Client, vpcID *string, logger logr.Logger) (bool, error) {
+
+	vpcList, err := client.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: []*string{vpcID}})
+	if err != nil {
+		logger.Error(err, "Failed to describe VPC", "ID", *vpcID)
+		return false, err
+	}
+
+	for _, vpc := range vpcList.Vpcs {
+		for _, association := range vpc.Ipv6CidrBlockAssociationSet {
+			if association.Ipv6CidrBlockState != nil && aws.StringValue(association.Ipv6CidrBlockState.State) == ec2.VpcCidrBlockStateCodeAssociated {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// ResetDefaultNetworkAcl removes the custom entries of a default network ACL and restores the allow all entries AWS created with it
+// the IPv6 allow all entries are only restored when the VPC has an IPv6 CIDR block
+func ResetDefaultNetworkAcl(client clientpkg.Client, acl *ec2.NetworkAcl, logger logr.Logger) error {
+
+	errFlag := false
+	// the allow all entries still in place, by rule number and direction
+	hasAllowAll := map[int64]map[bool]bool{defaultNetworkAclRuleNumber: {}, defaultNetworkAclRuleNumber + 1: {}}
+	for _, entry := range acl.Entries {
+		if isAwsNetworkAclEntry(entry) {
+			if directions, ok := hasAllowAll[aws.Int64Value(entry.RuleNumber)]; ok {
+				directions[aws.BoolValue(entry.Egress)] = true
+			}
+			continue
+		}
+		_, err := client.DeleteNetworkAclEntry(&ec2.DeleteNetworkAclEntryInput{NetworkAclId: acl.NetworkAclId, Egress: entry.Egress, RuleNumber: entry.RuleNumber})
+		if err != nil {
+			logger.Error(err, "Failed to delete custom entry of the default ACL", "ID", *acl.NetworkAclId, "RuleNumber", aws.Int64Value(entry.RuleNumber))
+			errFlag = true
+		}
+	}
+
+	var entriesToRestore []*ec2.CreateNetworkAclEntryInput
+	for _, egress := range []bool{false, true} {
+		if !hasAllowAll[defaultNetworkAclRuleNumber][egress] {
+			entriesToRestore = append(entriesToRestore, &ec2.CreateNetworkAclEntryInput{
+				Egress:     aws.Bool(egress),
+				RuleNumber: aws.Int64(defaultNetworkAclRuleNumber),
+				CidrBlock:  aws.String("0.0.0.0/0"),
+			})
+		}
+	}
+
+	ipv6Directions := hasAllowAll[defaultNetworkAclRuleNumber+1]
+	if !ipv6Directions[false] || !ipv6Directions[true] {
+		hasIpv6, err := vpcHasIpv6CidrBlock(client, acl.VpcId, logger)
+		if err != nil {
+			errFlag = true
+		}
+		for _, egress := range []bool{false, true} {
+			if hasIpv6 && !ipv6Directions[egress] {
+				entriesToRestore = append(entriesToRestore, &ec2.CreateNetworkAclEntryInput{
+					Egress:        aws.Bool(egress),
+					RuleNumber:    aws.Int64(defaultNetworkAclRuleNumber + 1),
+					Ipv6CidrBlock: aws.String("::/0"),
+				})
+			}
+		}
+	}
+
+	for _, entry := range entriesToRestore {
+		entry.NetworkAclId = acl.NetworkAclId
+		entry.Protocol = aws.String("-1")
+		entry.RuleAction = aws.String(ec2.RuleActionAllow)
+		_, err := client.CreateNetworkAclEntry(entry)
+		if err != nil {
+			logger.Error(err, "Failed to restore the allow all entry of the default ACL", "ID", *acl.NetworkAclId, "RuleNumber", aws.Int64Value(entry.RuleNumber), "Egress", aws.BoolValue(entry.Egress))
+			errFlag = true
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedToResetDefaultNetworkAcl")
+	}
 	return nil
 }
 
@@ -557,3 +889,384 @@ func DetachVpnGateway(client clientpkg.Client, vpcId *string, logger logr.Logger
 	return nil
 
 }
+
+// DeleteVpnGateways detaches every VPN gateway in the region from its VPC and deletes it
+// detaching takes a while, a gateway that is still detaching is deleted on the next pass
+func DeleteVpnGateways(client clientpkg.Client, logger logr.Logger) error {
+
+	// does not require pagination
+	vpnGatewayList, err := client.DescribeVpnGateways(&ec2.DescribeVpnGatewaysInput{})
+	if err != nil {
+		logger.Error(err, "Failed to retrieve VPN Gateway list")
+		return err
+	}
+
+	errFlag := false
+	for _, vpnGateway := range vpnGatewayList.VpnGateways {
+		state := aws.StringValue(vpnGateway.State)
+		if state == ec2.VpnStateDeleting || state == ec2.VpnStateDeleted {
+			continue
+		}
+
+		for _, attachment := range vpnGateway.VpcAttachments {
+			if aws.StringValue(attachment.State) != ec2.AttachmentStatusAttached {
+				continue
+			}
+			_, err = client.DetachVpnGateway(&ec2.DetachVpnGatewayInput{VpcId: attachment.VpcId, VpnGatewayId: vpnGateway.VpnGatewayId})
+			if err != nil {
+				logger.Error(err, "Failed to detach VPN gateway", "ID", *vpnGateway.VpnGatewayId, "VpcID", aws.StringValue(attachment.VpcId))
+			}
+		}
+
+		_, err = client.DeleteVpnGateway(&ec2.DeleteVpnGatewayInput{VpnGatewayId: vpnGateway.VpnGatewayId})
+		if err != nil {
+			logger.Error(err, "Failed to delete VPN gateway", "ID", *vpnGateway.VpnGatewayId)
+			errFlag = true
+			localMetrics.ResourceFail(localMetrics.VpnGateway, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.VpnGateway, client.GetRegion())
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveVpnGatewayDeletion")
+	}
+	return nil
+}
+
+// DeleteCustomerGateways deletes every customer gateway in the region
+// a customer gateway can only be deleted once its VPN connections are gone
+func DeleteCustomerGateways(client clientpkg.Client, logger logr.Logger) error {
+
+	// does not require pagination
+	customerGatewayList, err := client.DescribeCustomerGateways(&ec2.DescribeCustomerGatewaysInput{})
+	if err != nil {
+		logger.Error(err, "Failed to retrieve Customer Gateway list")
+		return err
+	}
+
+	errFlag := false
+	for _, customerGateway := range customerGatewayList.CustomerGateways {
+		state := aws.StringValue(customerGateway.State)
+		if state == "deleting" || state == "deleted" {
+			continue
+		}
+
+		_, err = client.DeleteCustomerGateway(&ec2.DeleteCustomerGatewayInput{CustomerGatewayId: customerGateway.CustomerGatewayId})
+		if err != nil {
+			logger.Error(err, "Failed to delete Customer Gateway", "ID", *customerGateway.CustomerGatewayId)
+			errFlag = true
+			localMetrics.ResourceFail(localMetrics.CustomerGateway, client.GetRegion())
+			continue
+		}
+		localMetrics.ResourceSuccess(localMetrics.CustomerGateway, client.GetRegion())
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveCustomerGatewayDeletion")
+	}
+	return nil
+}
+
+// DeleteEgressOnlyInternetGateways deletes the egress-only internet gateways attached to the VPC
+func DeleteEgressOnlyInternetGateways(client clientpkg.Client, vpcID *string, logger logr.Logger) error {
+	errFlag := false
+	var token *string
+
+	for {
+		gatewayList, err := client.DescribeEgressOnlyInternetGateways(&ec2.DescribeEgressOnlyInternetGatewaysInput{
+			Filters:   []*ec2.Filter{{Name: aws.String("attachment.vpc-id"), Values: []*string{vpcID}}},
+			NextToken: token,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to list Egress-only Internet Gateways")
+			return err
+		}
+
+		for _, gateway := range gatewayList.EgressOnlyInternetGateways {
+			for _, attachment := range gateway.Attachments {
+				if aws.StringValue(attachment.VpcId) != *vpcID {
+					continue
+				}
+				_, err := client.DeleteEgressOnlyInternetGateway(&ec2.DeleteEgressOnlyInternetGatewayInput{EgressOnlyInternetGatewayId: gateway.EgressOnlyInternetGatewayId})
+				if err != nil {
+					logger.Error(err, "Failed to delete Egress-only Internet Gateway", "ID", *gateway.EgressOnlyInternetGatewayId)
+					errFlag = true
+					localMetrics.ResourceFail(localMetrics.EgressOnlyGateway, client.GetRegion())
+					continue
+				}
+				localMetrics.ResourceSuccess(localMetrics.EgressOnlyGateway, client.GetRegion())
+			}
+		}
+
+		if gatewayList.NextToken != nil {
+			token = gatewayList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveEgressOnlyInternetGatewayDeletion")
+	}
+	return nil
+}
+
+// DeleteCarrierGateways deletes the carrier gateways of the VPC, Wavelength zones route through them
+func DeleteCarrierGateways(client clientpkg.Client, vpcID *string, logger logr.Logger) error {
+	errFlag := false
+	var token *string
+
+	for {
+		gatewayList, err := client.DescribeCarrierGateways(&ec2.DescribeCarrierGatewaysInput{
+			Filters:   []*ec2.Filter{{Name: aws.String("vpc-id"), Values: []*string{vpcID}}},
+			NextToken: token,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to list Carrier Gateways")
+			return err
+		}
+
+		for _, gateway := range gatewayList.CarrierGateways {
+			switch aws.StringValue(gateway.State) {
+			case ec2.CarrierGatewayStateDeleting, ec2.CarrierGatewayStateDeleted:
+				continue
+			}
+			_, err := client.DeleteCarrierGateway(&ec2.DeleteCarrierGatewayInput{CarrierGatewayId: gateway.CarrierGatewayId})
+			if err != nil {
+				logger.Error(err, "Failed to delete Carrier Gateway", "ID", *gateway.CarrierGatewayId)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.CarrierGateway, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.CarrierGateway, client.GetRegion())
+		}
+
+		if gatewayList.NextToken != nil {
+			token = gatewayList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveCarrierGatewayDeletion")
+	}
+	return nil
+}
+
+// DeleteManagedPrefixLists deletes the customer-managed prefix lists owned by the account
+// AWS-managed lists and lists shared by other accounts are left alone, a list can only go once no route or security group references it
+func DeleteManagedPrefixLists(client clientpkg.Client, logger logr.Logger) error {
+	identity, err := client.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		logger.Error(err, "Failed to get the account ID owning the prefix lists")
+		return err
+	}
+
+	errFlag := false
+	var token *string
+	for {
+		prefixListList, err := client.DescribeManagedPrefixLists(&ec2.DescribeManagedPrefixListsInput{
+			Filters:   []*ec2.Filter{{Name: aws.String("owner-id"), Values: []*string{identity.Account}}},
+			NextToken: token,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to list managed prefix lists")
+			return err
+		}
+
+		for _, prefixList := range prefixListList.PrefixLists {
+			if aws.StringValue(prefixList.OwnerId) != aws.StringValue(identity.Account) {
+				continue
+			}
+			switch aws.StringValue(prefixList.State) {
+			case ec2.PrefixListStateDeleteInProgress, ec2.PrefixListStateDeleteComplete:
+				continue
+			}
+			_, err := client.DeleteManagedPrefixList(&ec2.DeleteManagedPrefixListInput{PrefixListId: prefixList.PrefixListId})
+			if err != nil {
+				logger.Error(err, "Failed to delete managed prefix list", "ID", *prefixList.PrefixListId)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.PrefixList, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.PrefixList, client.GetRegion())
+		}
+
+		if prefixListList.NextToken != nil {
+			token = prefixListList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveManagedPrefixListDeletion")
+	}
+	return nil
+}
+
+// DeleteVpcFlowLogs deletes the flow logs publishing traffic of the VPC
+func DeleteVpcFlowLogs(client clientpkg.Client, vpcID *string, logger logr.Logger) error {
+
+	var flowLogsToBeDeleted []*string
+	var token *string
+	for {
+		flowLogList, err := client.DescribeFlowLogs(&ec2.DescribeFlowLogsInput{
+			Filter:    []*ec2.Filter{{Name: aws.String("resource-id"), Values: []*string{vpcID}}},
+			NextToken: token,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to list VPC flow logs")
+			return err
+		}
+
+		for _, flowLog := range flowLogList.FlowLogs {
+			flowLogsToBeDeleted = append(flowLogsToBeDeleted, flowLog.FlowLogId)
+		}
+
+		if flowLogList.NextToken != nil {
+			token = flowLogList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if flowLogsToBeDeleted == nil {
+		return nil
+	}
+
+	output, err := client.DeleteFlowLogs(&ec2.DeleteFlowLogsInput{FlowLogIds: flowLogsToBeDeleted})
+	if err != nil {
+		logger.Error(err, "Failed to delete VPC flow logs", "VpcID", *vpcID)
+		localMetrics.ResourceFail(localMetrics.FlowLog, client.GetRegion())
+		return err
+	}
+	for _, item := range output.Unsuccessful {
+		logger.Info("Failed to delete VPC flow log", "ID", aws.StringValue(item.ResourceId), "Error", item.Error.String())
+		localMetrics.ResourceFail(localMetrics.FlowLog, client.GetRegion())
+	}
+	if len(output.Unsuccessful) > 0 {
+		return errors.New("FailedComprehensiveFlowLogDeletion")
+	}
+	localMetrics.ResourceSuccess(localMetrics.FlowLog, client.GetRegion())
+	return nil
+}
+
+// DisassociateSecondaryVpcCidrBlocks disassociates every IPv4 CIDR block but the primary one, and every IPv6 CIDR block, from the VPC
+// a CIDR block can only be disassociated once the subnets using it are gone
+func DisassociateSecondaryVpcCidrBlocks(client clientpkg.Client, vpcID *string, logger logr.Logger) error {
+
+	vpcList, err := client.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: []*string{vpcID}})
+	if err != nil {
+		logger.Error(err, "Failed to describe VPC", "ID", *vpcID)
+		return err
+	}
+
+	var associationIDs []*string
+	for _, vpc := range vpcList.Vpcs {
+		for _, association := range vpc.CidrBlockAssociationSet {
+			if aws.StringValue(association.CidrBlock) == aws.StringValue(vpc.CidrBlock) {
+				continue
+			}
+			if association.CidrBlockState != nil && aws.StringValue(association.CidrBlockState.State) == ec2.VpcCidrBlockStateCodeAssociated {
+				associationIDs = append(associationIDs, association.AssociationId)
+			}
+		}
+		for _, association := range vpc.Ipv6CidrBlockAssociationSet {
+			if association.Ipv6CidrBlockState != nil && aws.StringValue(association.Ipv6CidrBlockState.State) == ec2.VpcCidrBlockStateCodeAssociated {
+				associationIDs = append(associationIDs, association.AssociationId)
+			}
+		}
+	}
+
+	errFlag := false
+	for _, associationID := range associationIDs {
+		_, err := client.DisassociateVpcCidrBlock(&ec2.DisassociateVpcCidrBlockInput{AssociationId: associationID})
+		if err != nil {
+			logger.Error(err, "Failed to disassociate VPC CIDR block", "ID", *associationID, "VpcID", *vpcID)
+			errFlag = true
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveVpcCidrBlockDisassociation")
+	}
+	return nil
+}
+
+// isAwsDefaultDhcpOptions reports whether the DHCP option set looks like the one AWS creates for every region
+func isAwsDefaultDhcpOptions(dhcpOptions *ec2.DhcpOptions) bool {
+	for _, configuration := range dhcpOptions.DhcpConfigurations {
+		if aws.StringValue(configuration.Key) != "domain-name" {
+			continue
+		}
+		for _, value := range configuration.Values {
+			domainName := aws.StringValue(value.Value)
+			if domainName == "ec2.internal" || strings.HasSuffix(domainName, ".compute.internal") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CleanDhcpOptions deletes the DHCP option sets in the region that are not associated with any VPC
+// the option set created by AWS is kept so that default VPCs can still be associated with it
+func CleanDhcpOptions(client clientpkg.Client, logger logr.Logger) error {
+
+	inUse := map[string]bool{}
+	var token *string
+	for {
+		vpcList, err := client.DescribeVpcs(&ec2.DescribeVpcsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list VPCs")
+			return err
+		}
+		for _, vpc := range vpcList.Vpcs {
+			inUse[aws.StringValue(vpc.DhcpOptionsId)] = true
+		}
+		if vpcList.NextToken != nil {
+			token = vpcList.NextToken
+		} else {
+			break
+		}
+	}
+
+	errFlag := false
+	token = nil
+	for {
+		dhcpOptionsList, err := client.DescribeDhcpOptions(&ec2.DescribeDhcpOptionsInput{NextToken: token})
+		if err != nil {
+			logger.Error(err, "Failed to list DHCP option sets")
+			return err
+		}
+
+		for _, dhcpOptions := range dhcpOptionsList.DhcpOptions {
+			if inUse[aws.StringValue(dhcpOptions.DhcpOptionsId)] || isAwsDefaultDhcpOptions(dhcpOptions) {
+				continue
+			}
+			_, err := client.DeleteDhcpOptions(&ec2.DeleteDhcpOptionsInput{DhcpOptionsId: dhcpOptions.DhcpOptionsId})
+			if err != nil {
+				logger.Error(err, "Failed to delete DHCP option set", "ID", *dhcpOptions.DhcpOptionsId)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.DhcpOptions, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.DhcpOptions, client.GetRegion())
+		}
+
+		if dhcpOptionsList.NextToken != nil {
+			token = dhcpOptionsList.NextToken
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanDhcpOptions")
+	}
+	logger.Info("All unused DHCP option sets have been deleted for this region")
+	return nil
+}
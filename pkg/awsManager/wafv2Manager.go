@@ -0,0 +1,105 @@
+package awsManager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+	"github.com/openshift/aws-account-shredder/pkg/localMetrics"
+)
+
+// DeleteWafv2WebACLs deletes the web ACLs of the given scope
+// CLOUDFRONT scoped ACLs are only reachable through us-east-1, REGIONAL ones through the region they live in
+func DeleteWafv2WebACLs(client clientpkg.Client, scope string, logger logr.Logger) error {
+
+	errFlag := false
+	var marker *string
+	for {
+		webACLList, err := client.ListWebACLs(&wafv2.ListWebACLsInput{Scope: &scope, NextMarker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list WAFv2 web ACLs", "Scope", scope)
+			return err
+		}
+
+		for _, webACL := range webACLList.WebACLs {
+			_, err = client.DeleteWebACL(&wafv2.DeleteWebACLInput{Id: webACL.Id, Name: webACL.Name, LockToken: webACL.LockToken, Scope: &scope})
+			if err != nil {
+				logger.Error(err, "Failed to delete WAFv2 web ACL", "Scope", scope, "Name", *webACL.Name)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.WafWebACL, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.WafWebACL, client.GetRegion())
+		}
+
+		// the last page comes with a marker as well, but no ACLs
+		if webACLList.NextMarker != nil && len(webACLList.WebACLs) > 0 {
+			marker = webACLList.NextMarker
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveWafv2WebACLDeletion")
+	}
+	return nil
+}
+
+// DeleteWafv2IPSets deletes the IP sets of the given scope, they can not be deleted while a web ACL references them
+func DeleteWafv2IPSets(client clientpkg.Client, scope string, logger logr.Logger) error {
+
+	errFlag := false
+	var marker *string
+	for {
+		ipSetList, err := client.ListIPSets(&wafv2.ListIPSetsInput{Scope: &scope, NextMarker: marker})
+		if err != nil {
+			logger.Error(err, "Failed to list WAFv2 IP sets", "Scope", scope)
+			return err
+		}
+
+		for _, ipSet := range ipSetList.IPSets {
+			_, err = client.DeleteIPSet(&wafv2.DeleteIPSetInput{Id: ipSet.Id, Name: ipSet.Name, LockToken: ipSet.LockToken, Scope: &scope})
+			if err != nil {
+				logger.Error(err, "Failed to delete WAFv2 IP set", "Scope", scope, "Name", *ipSet.Name)
+				errFlag = true
+				localMetrics.ResourceFail(localMetrics.WafIPSet, client.GetRegion())
+				continue
+			}
+			localMetrics.ResourceSuccess(localMetrics.WafIPSet, client.GetRegion())
+		}
+
+		if ipSetList.NextMarker != nil && len(ipSetList.IPSets) > 0 {
+			marker = ipSetList.NextMarker
+		} else {
+			break
+		}
+	}
+
+	if errFlag {
+		return errors.New("FailedComprehensiveWafv2IPSetDeletion")
+	}
+	return nil
+}
+
+// CleanWafv2 deletes the WAFv2 web ACLs and IP sets of the given scope (wafv2.ScopeCloudfront or wafv2.ScopeRegional)
+// regional web ACLs associated with a load balancer can only go once the load balancer is deleted
+func CleanWafv2(client clientpkg.Client, scope string, logger logr.Logger) error {
+
+	errFlag := false
+
+	if err := DeleteWafv2WebACLs(client, scope, logger); err != nil {
+		errFlag = true
+	}
+
+	if err := DeleteWafv2IPSets(client, scope, logger); err != nil {
+		errFlag = true
+	}
+
+	if errFlag {
+		return errors.New("FailedToCleanWafv2")
+	}
+	logger.Info("All WAFv2 web ACLs and IP sets have been deleted", "Scope", scope)
+	return nil
+}
@@ -0,0 +1,139 @@
+package awsManager
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/go-logr/logr"
+	clientpkg "github.com/openshift/aws-account-shredder/pkg/aws"
+)
+
+// ErrWaitTimeout indicates resources were still being deleted when the wait timeout expired
+var ErrWaitTimeout = errors.New("WaitTimeout")
+
+// waiterPollInterval is the time between two checks of a waiter
+var waiterPollInterval = 10 * time.Second
+
+// elbRequesterID is the requester of the network interfaces load balancers keep in the VPC subnets
+const elbRequesterID = "amazon-elb"
+
+// waitUntil calls done every waiterPollInterval until it reports true or fails
+// ErrWaitTimeout is returned when done still reports false after the timeout
+func waitUntil(timeout time.Duration, done func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		finished, err := done()
+		if err != nil {
+			return err
+		}
+		if finished {
+			return nil
+		}
+		if time.Now().Add(waiterPollInterval).After(deadline) {
+			return ErrWaitTimeout
+		}
+		time.Sleep(waiterPollInterval)
+	}
+}
+
+// vpcFilter returns the filters matching the resources of the VPC with the given name and values
+func vpcFilter(vpcID *string, name string, values ...string) []*ec2.Filter {
+	return []*ec2.Filter{
+		{Name: aws.String("vpc-id"), Values: []*string{vpcID}},
+		{Name: aws.String(name), Values: aws.StringSlice(values)},
+	}
+}
+
+// WaitForVpcInstancesTerminated waits for the instances of the VPC that are shutting down to be terminated
+// shutting-down instances still hold their network interfaces and security groups
+func WaitForVpcInstancesTerminated(client clientpkg.Client, vpcID *string, timeout time.Duration, logger logr.Logger) error {
+	err := waitUntil(timeout, func() (bool, error) {
+		instanceList, err := client.DescribeInstances(&ec2.DescribeInstancesInput{Filters: vpcFilter(vpcID, "instance-state-name", ec2.InstanceStateNameShuttingDown)})
+		if err != nil {
+			logger.Error(err, "Failed to describe shutting-down instances", "VpcID", *vpcID)
+			return false, err
+		}
+		for _, reservation := range instanceList.Reservations {
+			if len(reservation.Instances) > 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err == ErrWaitTimeout {
+		logger.Info("Timed out waiting for instances to terminate", "VpcID", *vpcID, "Timeout", timeout.String())
+	}
+	return err
+}
+
+// WaitForVpcNatGatewaysDeleted waits for the NAT gateways of the VPC that are being deleted to be gone
+// a NAT gateway releases its network interface and elastic IP once deleted
+func WaitForVpcNatGatewaysDeleted(client clientpkg.Client, vpcID *string, timeout time.Duration, logger logr.Logger) error {
+	err := waitUntil(timeout, func() (bool, error) {
+		natGatewayList, err := client.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{Filter: vpcFilter(vpcID, "state", ec2.NatGatewayStateDeleting)})
+		if err != nil {
+			logger.Error(err, "Failed to describe deleting NAT gateways", "VpcID", *vpcID)
+			return false, err
+		}
+		return len(natGatewayList.NatGateways) == 0, nil
+	})
+	if err == ErrWaitTimeout {
+		logger.Info("Timed out waiting for NAT gateways to be deleted", "VpcID", *vpcID, "Timeout", timeout.String())
+	}
+	return err
+}
+
+// WaitForVpcLoadBalancersDeleted waits for the network interfaces of the deleted load balancers of the VPC to be released
+// load balancers disappear from the API right away, but their network interfaces linger for a few minutes
+func WaitForVpcLoadBalancersDeleted(client clientpkg.Client, vpcID *string, timeout time.Duration, logger logr.Logger) error {
+	err := waitUntil(timeout, func() (bool, error) {
+		networkInterfaceList, err := client.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{Filters: vpcFilter(vpcID, "requester-id", elbRequesterID)})
+		if err != nil {
+			logger.Error(err, "Failed to describe load balancer network interfaces", "VpcID", *vpcID)
+			return false, err
+		}
+		return len(networkInterfaceList.NetworkInterfaces) == 0, nil
+	})
+	if err == ErrWaitTimeout {
+		logger.Info("Timed out waiting for load balancers to be deleted", "VpcID", *vpcID, "Timeout", timeout.String())
+	}
+	return err
+}
+
+// waitDeadline returns the deadline of a wait of up to timeout starting now
+// a timeout of 0 disables waiting and gives the zero time
+func waitDeadline(timeout time.Duration) time.Time {
+	if timeout <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(timeout)
+}
+
+// WaitForVpcDependencies waits for the instances, NAT gateways and load balancers of the VPC to be deleted, up to the deadline
+// the deadline is shared by the VPCs of a pass, each waiter gets the time left and the first one failing or timing out ends the wait
+// the network interfaces of load balancers are only waited for when waitForLoadBalancers is set, as they never go while their load balancer remains
+// a zero deadline skips waiting, and the deletions depending on them are left to fail and be retried on the next pass
+func WaitForVpcDependencies(client clientpkg.Client, vpcID *string, deadline time.Time, waitForLoadBalancers bool, logger logr.Logger) error {
+	if deadline.IsZero() {
+		return nil
+	}
+	waiters := []func(clientpkg.Client, *string, time.Duration, logr.Logger) error{
+		WaitForVpcInstancesTerminated,
+		WaitForVpcNatGatewaysDeleted,
+	}
+	if waitForLoadBalancers {
+		waiters = append(waiters, WaitForVpcLoadBalancersDeleted)
+	}
+	for _, wait := range waiters {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return ErrWaitTimeout
+		}
+		if err := wait(client, vpcID, remaining, logger); err != nil {
+			return err
+		}
+	}
+	return nil
+}
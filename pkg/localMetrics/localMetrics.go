@@ -14,13 +14,31 @@ const (
 	EbsVolume           = "ebs_volume"
 	EbsSnapshot         = "ebs_snapshot"
 	Ec2Instance         = "ec2_instance"
+	SpotRequest         = "ec2_spot_instance_request"
+	SpotFleetRequest    = "ec2_spot_fleet_request"
+	KeyPair             = "ec2_key_pair"
+	PlacementGroup      = "ec2_placement_group"
+	DedicatedHost       = "ec2_dedicated_host"
+	CapacityReservation = "ec2_capacity_reservation"
 	EfsVolume           = "efs_volume"
 	Route53RecordSet    = "route53_record_set"
 	Route53HostedZone   = "route53_hosted_zone"
+	Route53HealthCheck  = "route53_health_check"
+	TrafficPolicyInst   = "route53_traffic_policy_instance"
+	ResolverRule        = "route53_resolver_rule"
+	ResolverEndpoint    = "route53_resolver_endpoint"
+	ResolverQueryLog    = "route53_resolver_query_log_config"
+	DNSFirewallGroup    = "route53_resolver_firewall_rule_group"
+	CloudFrontDist      = "cloudfront_distribution"
+	AcmCertificate      = "acm_certificate"
+	WafWebACL           = "wafv2_web_acl"
+	WafIPSet            = "wafv2_ip_set"
+	Accelerator         = "global_accelerator"
 	S3Bucket            = "s3_bucket"
 	ElasticLoadBalancer = "elastic_loadbalancer"
 	NatGateway          = "nat_gateway"
 	NetworkLoadBalancer = "network_loadbalancer"
+	TargetGroup         = "target_group"
 	NetworkInterface    = "network_interface"
 	InternetGateway     = "internet_gateway"
 	Subnet              = "subnet"
@@ -28,8 +46,60 @@ const (
 	NetworkACL          = "network_acl"
 	SecurityGroup       = "security_group"
 	VPC                 = "vpc"
+	DefaultVpc          = "default_vpc"
 	VpnConnection       = "vpn_connection"
 	VpnGateway          = "vpn_gateway"
+	CustomerGateway     = "customer_gateway"
+	VpcPeering          = "vpc_peering_connection"
+	TransitGateway      = "transit_gateway"
+	TgwAttachment       = "transit_gateway_attachment"
+	TgwRouteTable       = "transit_gateway_route_table"
+	EgressOnlyGateway   = "egress_only_internet_gateway"
+	CarrierGateway      = "carrier_gateway"
+	PrefixList          = "managed_prefix_list"
+	FlowLog             = "flow_log"
+	DhcpOptions         = "dhcp_options"
+	EndpointService     = "vpc_endpoint_service"
+	LambdaFunction      = "lambda_function"
+	LambdaLayerVersion  = "lambda_layer_version"
+	LambdaEventMapping  = "lambda_event_source_mapping"
+	EventBridgeRule     = "eventbridge_rule"
+	EventBridgeBus      = "eventbridge_event_bus"
+	SfnStateMachine     = "sfn_state_machine"
+	EcrRepository       = "ecr_repository"
+	EcsService          = "ecs_service"
+	EcsTask             = "ecs_task"
+	EcsContainer        = "ecs_container_instance"
+	EcsCluster          = "ecs_cluster"
+	EcsTaskDefinition   = "ecs_task_definition"
+	EksNodegroup        = "eks_nodegroup"
+	EksFargateProfile   = "eks_fargate_profile"
+	EksCluster          = "eks_cluster"
+	CloudWatchLogGroup  = "cloudwatch_log_group"
+	CloudWatchAlarm     = "cloudwatch_alarm"
+	CloudWatchDashboard = "cloudwatch_dashboard"
+	MetricStream        = "cloudwatch_metric_stream"
+	KmsKey              = "kms_key"
+	KmsAlias            = "kms_alias"
+	DynamoDBTable       = "dynamodb_table"
+	DynamoDBBackup      = "dynamodb_backup"
+	SqsQueue            = "sqs_queue"
+	SnsTopic            = "sns_topic"
+	SnsSubscription     = "sns_subscription"
+	KinesisStream       = "kinesis_stream"
+	FirehoseStream      = "firehose_delivery_stream"
+	Secret              = "secretsmanager_secret"
+	SsmParameter        = "ssm_parameter"
+	SsmDocument         = "ssm_document"
+	SsmMaintWindow      = "ssm_maintenance_window"
+	CacheReplGroup      = "elasticache_replication_group"
+	CacheCluster        = "elasticache_cluster"
+	CacheSnapshot       = "elasticache_snapshot"
+	CacheSubnetGroup    = "elasticache_subnet_group"
+	EsDomain            = "opensearch_domain"
+	RedshiftCluster     = "redshift_cluster"
+	RedshiftSnapshot    = "redshift_snapshot"
+	RedshiftSubnetGroup = "redshift_subnet_group"
 )
 
 // Creates a Metrics struct
@@ -38,6 +108,7 @@ type MetricsStruct struct {
 	AccountFail     prometheus.Counter
 	ResourceSuccess *prometheus.CounterVec
 	ResourceFail    *prometheus.CounterVec
+	ResourceLocked  *prometheus.CounterVec
 	DurationSeconds prometheus.Histogram
 }
 
@@ -60,6 +131,10 @@ func Initialize(metricsPort string, metricsPath string) error {
 			Name: "aws_account_shredder_resources_failed",
 			Help: "Count of specific AWS Resources that have failed to shred",
 		}, []string{"resource_type", "region"}),
+		ResourceLocked: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "aws_account_shredder_resources_locked",
+			Help: "Count of specific AWS Resources that can not be shredded until their retention period ends",
+		}, []string{"resource_type", "region"}),
 		DurationSeconds: prometheus.NewHistogram(prometheus.HistogramOpts{
 			Name:    "aws_account_shredder_duration_seconds",
 			Help:    "Distribution of the number of seconds a AWS Shred operation takes",
@@ -72,6 +147,7 @@ func Initialize(metricsPort string, metricsPath string) error {
 		Metrics.AccountFail,
 		*Metrics.ResourceSuccess,
 		*Metrics.ResourceFail,
+		*Metrics.ResourceLocked,
 		Metrics.DurationSeconds,
 	}
 
@@ -91,3 +167,6 @@ func ResourceSuccess(resourceType string, region string) {
 func ResourceFail(resourceType string, region string) {
 	Metrics.ResourceFail.With(prometheus.Labels{"resource_type": resourceType, "region": region}).Inc()
 }
+func ResourceLocked(resourceType string, region string) {
+	Metrics.ResourceLocked.With(prometheus.Labels{"resource_type": resourceType, "region": region}).Inc()
+}